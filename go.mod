@@ -3,6 +3,7 @@ module github.com/seinshah/civic
 go 1.24
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/Masterminds/sprig/v3 v3.2.3
 	github.com/chromedp/cdproto v0.0.0-20250307225615-b9fffb6d31ad
 	github.com/chromedp/chromedp v0.13.1
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/loader"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+var (
+	errUnsupportedFormat    = errors.New("output format is not supported (valid formats: pdf, html)")
+	errUnsupportedMediaType = errors.New("content type is not supported (valid types: YAML, JSON, TOML)")
+)
+
+// errorResponse is the body of the failed requests.
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type errorMapping struct {
+	target error
+	status int
+	code   string
+}
+
+// errorMappings maps the errors to the status and the code of the response.
+// The first matching mapping wins, and the rest of the errors are internal errors.
+//
+//nolint:gochecknoglobals
+var errorMappings = []errorMapping{
+	{target: errUnsupportedFormat, status: http.StatusBadRequest, code: "unsupported_format"},
+	{target: types.ErrInvalidOutputType, status: http.StatusBadRequest, code: "unsupported_format"},
+	{target: errUnsupportedMediaType, status: http.StatusUnsupportedMediaType, code: "unsupported_media_type"},
+	{target: cv.ErrLocalFileNotAllowed, status: http.StatusUnprocessableEntity, code: "local_file_not_allowed"},
+	{target: cv.ErrRemoteHostNotAllowed, status: http.StatusUnprocessableEntity, code: "remote_host_not_allowed"},
+	{target: cv.ErrInvalidSchemaFormat, status: http.StatusUnprocessableEntity, code: "invalid_schema"},
+	{target: cv.ErrSchemaCycle, status: http.StatusUnprocessableEntity, code: "invalid_schema"},
+	{target: cv.ErrInvalidSchemaLink, status: http.StatusUnprocessableEntity, code: "invalid_schema"},
+	{target: types.ErrInvalidSchemaType, status: http.StatusUnprocessableEntity, code: "invalid_schema"},
+	{target: types.ErrProfileNotFound, status: http.StatusUnprocessableEntity, code: "invalid_schema"},
+	{target: cv.ErrTemplateNotProvided, status: http.StatusUnprocessableEntity, code: "invalid_template"},
+	{target: cv.ErrNonParsableTemplate, status: http.StatusUnprocessableEntity, code: "invalid_template"},
+	{target: cv.ErrFoundInvalidTag, status: http.StatusUnprocessableEntity, code: "invalid_template"},
+	{target: cv.ErrInvalidDirective, status: http.StatusUnprocessableEntity, code: "invalid_template"},
+	{target: cv.ErrMismatchAppVersion, status: http.StatusUnprocessableEntity, code: "unsupported_template_version"},
+	{target: chrome.ErrPageOverflow, status: http.StatusUnprocessableEntity, code: "page_overflow"},
+	{target: loader.ErrInvalidRemotePath, status: http.StatusBadGateway, code: "remote_file_unavailable"},
+	{target: context.DeadlineExceeded, status: http.StatusGatewayTimeout, code: "timeout"},
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status, code := http.StatusInternalServerError, "internal_error"
+
+	var maxBytesErr *http.MaxBytesError
+
+	if errors.As(err, &maxBytesErr) {
+		status, code = http.StatusRequestEntityTooLarge, "request_too_large"
+	} else {
+		for _, mapping := range errorMappings {
+			if errors.Is(err, mapping.target) {
+				status, code = mapping.status, mapping.code
+
+				break
+			}
+		}
+	}
+
+	writeJSON(w, status, errorResponse{Error: errorBody{Code: code, Message: err.Error()}})
+}
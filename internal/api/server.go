@@ -0,0 +1,221 @@
+// Package api exposes the rendering of the CVs as an HTTP service.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 10 * time.Second
+)
+
+// Renderer renders the schemas provided in memory.
+type Renderer interface {
+	RenderContent(ctx context.Context, input cv.RenderInput) ([]byte, error)
+}
+
+type options struct {
+	maxBodySize int64
+	timeout     time.Duration
+}
+
+type Option func(*options)
+
+// WithMaxBodySize sets the maximum size of the request body in bytes.
+// Default is types.DefaultAPIMaxBodySize.
+func WithMaxBodySize(size int64) Option {
+	return func(o *options) {
+		o.maxBodySize = size
+	}
+}
+
+// WithTimeout sets the maximum duration of a render. Default is types.DefaultAPITimeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+type Server struct {
+	renderer Renderer
+	config   options
+}
+
+func NewServer(renderer Renderer, opts ...Option) *Server {
+	instanceOpts := options{
+		maxBodySize: types.DefaultAPIMaxBodySize,
+		timeout:     types.DefaultAPITimeout,
+	}
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	return &Server{
+		renderer: renderer,
+		config:   instanceOpts,
+	}
+}
+
+// Handler returns the HTTP handler serving the render endpoint on "POST /render",
+// and the health check on "GET /healthz".
+//
+// The request body of the render endpoint is the schema, and its type is detected from the
+// Content-Type header (YAML, JSON, or TOML). The output format is selected by the format
+// query parameter (pdf or html, default is pdf), and the template query parameter replaces
+// the template of the schema with a registry template name or a link to a template.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /render", s.handleRender)
+	mux.HandleFunc("GET /healthz", s.handleHealth)
+
+	return mux
+}
+
+// Serve serves the API on the listener until the context is done.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Failed to shut down the server", "error", err)
+		}
+	}()
+
+	slog.Info("Serving the API on http://" + listener.Addr().String())
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// ListenAndServe listens on the address and serves the API until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context, address string) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) handleRender(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	input, err := s.parseRenderRequest(w, r)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.config.timeout)
+	defer cancel()
+
+	content, err := s.renderer.RenderContent(ctx, input)
+	if err != nil {
+		slog.Warn("Failed to render the CV", "error", err, "format", input.OutputType)
+		writeError(w, err)
+
+		return
+	}
+
+	slog.Info("Rendered the CV", "format", input.OutputType, "duration", time.Since(start))
+
+	w.Header().Set("Content-Type", outputContentTypes[input.OutputType])
+	w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+	_, _ = w.Write(content)
+}
+
+func (s *Server) parseRenderRequest(w http.ResponseWriter, r *http.Request) (cv.RenderInput, error) {
+	input := cv.RenderInput{
+		OutputType: types.OutputTypePdf,
+	}
+
+	if format := r.URL.Query().Get("format"); format != "" {
+		input.OutputType = types.OutputType(strings.ToLower(format))
+	}
+
+	if _, ok := outputContentTypes[input.OutputType]; !ok {
+		return input, fmt.Errorf("%w: %s", errUnsupportedFormat, input.OutputType)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return input, fmt.Errorf("%w: %w", errUnsupportedMediaType, err)
+	}
+
+	if input.ContentType, err = schemaType(mediaType); err != nil {
+		return input, err
+	}
+
+	if template := r.URL.Query().Get("template"); template != "" {
+		input.Template = &types.SchemaTemplate{Name: template}
+
+		if strings.HasPrefix(template, "http://") || strings.HasPrefix(template, "https://") {
+			input.Template = &types.SchemaTemplate{Path: template}
+		}
+	}
+
+	if input.Content, err = io.ReadAll(http.MaxBytesReader(w, r.Body, s.config.maxBodySize)); err != nil {
+		return input, err
+	}
+
+	return input, nil
+}
+
+func schemaType(mediaType string) (types.SchemaType, error) {
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return types.SchemaTypeYaml, nil
+	case "application/json":
+		return types.SchemaTypeJson, nil
+	case "application/toml":
+		return types.SchemaTypeToml, nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnsupportedMediaType, mediaType)
+	}
+}
+
+//nolint:gochecknoglobals
+var outputContentTypes = map[types.OutputType]string{
+	types.OutputTypePdf:  "application/pdf",
+	types.OutputTypeHtml: "text/html; charset=utf-8",
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
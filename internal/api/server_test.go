@@ -0,0 +1,254 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seinshah/civic/internal/api"
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRenderer struct {
+	err   error
+	delay time.Duration
+	input *cv.RenderInput
+}
+
+func (f fakeRenderer) RenderContent(ctx context.Context, input cv.RenderInput) ([]byte, error) {
+	if f.input != nil {
+		*f.input = input
+	}
+
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, fmt.Errorf("render: %w", ctx.Err())
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if input.OutputType == types.OutputTypePdf {
+		return []byte("%PDF-1.4"), nil
+	}
+
+	return []byte("<html><body><h1>John Doe</h1></body></html>"), nil
+}
+
+type errorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func TestServer_Render(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		renderer      fakeRenderer
+		options       []api.Option
+		query         string
+		contentType   string
+		body          string
+		status        int
+		responseType  string
+		errorCode     string
+		expectedInput *cv.RenderInput
+	}{
+		{
+			name:         "pdf by default",
+			contentType:  "application/yaml",
+			body:         "bio: {}",
+			status:       http.StatusOK,
+			responseType: "application/pdf",
+			expectedInput: &cv.RenderInput{
+				Content:     []byte("bio: {}"),
+				ContentType: types.SchemaTypeYaml,
+				OutputType:  types.OutputTypePdf,
+			},
+		},
+		{
+			name:         "html with template name",
+			query:        "?format=HTML&template=genesis",
+			contentType:  "application/json; charset=utf-8",
+			body:         "{}",
+			status:       http.StatusOK,
+			responseType: "text/html; charset=utf-8",
+			expectedInput: &cv.RenderInput{
+				Content:     []byte("{}"),
+				ContentType: types.SchemaTypeJson,
+				OutputType:  types.OutputTypeHtml,
+				Template:    &types.SchemaTemplate{Name: "genesis"},
+			},
+		},
+		{
+			name:         "template link",
+			query:        "?template=https://example.com/template.html",
+			contentType:  "application/toml",
+			body:         "[bio]",
+			status:       http.StatusOK,
+			responseType: "application/pdf",
+			expectedInput: &cv.RenderInput{
+				Content:     []byte("[bio]"),
+				ContentType: types.SchemaTypeToml,
+				OutputType:  types.OutputTypePdf,
+				Template:    &types.SchemaTemplate{Path: "https://example.com/template.html"},
+			},
+		},
+		{
+			name:        "unsupported format",
+			query:       "?format=docx",
+			contentType: "application/yaml",
+			status:      http.StatusBadRequest,
+			errorCode:   "unsupported_format",
+		},
+		{
+			name:        "unsupported media type",
+			contentType: "text/plain",
+			status:      http.StatusUnsupportedMediaType,
+			errorCode:   "unsupported_media_type",
+		},
+		{
+			name:      "missing media type",
+			status:    http.StatusUnsupportedMediaType,
+			errorCode: "unsupported_media_type",
+		},
+		{
+			name:        "too large body",
+			options:     []api.Option{api.WithMaxBodySize(4)},
+			contentType: "application/yaml",
+			body:        "bio: {}",
+			status:      http.StatusRequestEntityTooLarge,
+			errorCode:   "request_too_large",
+		},
+		{
+			name:        "timeout",
+			renderer:    fakeRenderer{delay: time.Minute},
+			options:     []api.Option{api.WithTimeout(10 * time.Millisecond)},
+			contentType: "application/yaml",
+			status:      http.StatusGatewayTimeout,
+			errorCode:   "timeout",
+		},
+		{
+			name:        "invalid schema",
+			renderer:    fakeRenderer{err: fmt.Errorf("%w: bio is required", cv.ErrInvalidSchemaFormat)},
+			contentType: "application/yaml",
+			status:      http.StatusUnprocessableEntity,
+			errorCode:   "invalid_schema",
+		},
+		{
+			name:        "invalid template tag",
+			renderer:    fakeRenderer{err: fmt.Errorf("%w: script", cv.ErrFoundInvalidTag)},
+			contentType: "application/yaml",
+			status:      http.StatusUnprocessableEntity,
+			errorCode:   "invalid_template",
+		},
+		{
+			name:        "unsupported template version",
+			renderer:    fakeRenderer{err: cv.ErrMismatchAppVersion},
+			contentType: "application/yaml",
+			status:      http.StatusUnprocessableEntity,
+			errorCode:   "unsupported_template_version",
+		},
+		{
+			name:        "remote host not allowed",
+			renderer:    fakeRenderer{err: fmt.Errorf("%w: http://10.0.0.1/template.html", cv.ErrRemoteHostNotAllowed)},
+			contentType: "application/yaml",
+			status:      http.StatusUnprocessableEntity,
+			errorCode:   "remote_host_not_allowed",
+		},
+		{
+			name:        "page overflow",
+			renderer:    fakeRenderer{err: fmt.Errorf("%w: 3 pages", chrome.ErrPageOverflow)},
+			contentType: "application/yaml",
+			status:      http.StatusUnprocessableEntity,
+			errorCode:   "page_overflow",
+		},
+		{
+			name:        "internal error",
+			renderer:    fakeRenderer{err: context.Canceled},
+			contentType: "application/yaml",
+			status:      http.StatusInternalServerError,
+			errorCode:   "internal_error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				renderer := tc.renderer
+				renderer.input = &cv.RenderInput{}
+
+				request := httptest.NewRequest(http.MethodPost, "/render"+tc.query, strings.NewReader(tc.body))
+				if tc.contentType != "" {
+					request.Header.Set("Content-Type", tc.contentType)
+				}
+
+				recorder := httptest.NewRecorder()
+
+				api.NewServer(renderer, tc.options...).Handler().ServeHTTP(recorder, request)
+
+				require.Equal(t, tc.status, recorder.Code)
+
+				if tc.errorCode != "" {
+					var response errorResponse
+
+					require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+					require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+					require.Equal(t, tc.errorCode, response.Error.Code)
+					require.NotEmpty(t, response.Error.Message)
+
+					return
+				}
+
+				require.Equal(t, tc.responseType, recorder.Header().Get("Content-Type"))
+				require.NotEmpty(t, recorder.Body.Bytes())
+				require.Equal(t, tc.expectedInput, renderer.input)
+			},
+		)
+	}
+}
+
+func TestServer_RenderLocalFile(t *testing.T) {
+	t.Parallel()
+
+	renderer := cv.NewRenderer("v1.0.0", cv.WithoutLocalFiles())
+
+	request := httptest.NewRequest(http.MethodPost, "/render", strings.NewReader("extends: /etc/civic/base.yaml\n"))
+	request.Header.Set("Content-Type", "application/yaml")
+
+	recorder := httptest.NewRecorder()
+
+	api.NewServer(renderer).Handler().ServeHTTP(recorder, request)
+
+	var response errorResponse
+
+	require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Equal(t, "local_file_not_allowed", response.Error.Code)
+}
+
+func TestServer_Health(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+
+	api.NewServer(fakeRenderer{}).Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"status":"ok"}`, recorder.Body.String())
+}
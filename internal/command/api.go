@@ -0,0 +1,88 @@
+package command
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/seinshah/civic/internal/api"
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+func (c *Command) getAPICommand() *cobra.Command {
+	var (
+		address      string
+		maxBodySize  int64
+		timeout      time.Duration
+		maxTabs      int
+		allowedHosts []string
+		chromeFlags  chromeFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "api",
+		Short: "Serve an HTTP API rendering the resumes or cvs",
+		Long: `Start an HTTP server rendering the schemas posted to POST /render as PDF or HTML.
+The schemas cannot load local files, nor remote files from the hosts that are not allowed, and
+the renders share a single headless browser.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			browser, err := chrome.NewBrowser(cmd.Context(), maxTabs, chromeFlags.options()...)
+			if err != nil {
+				return err
+			}
+
+			defer func() {
+				if err := browser.Close(); err != nil {
+					slog.Warn("Failed to close the headless browser", "error", err)
+				}
+			}()
+
+			renderer := cv.NewRenderer(
+				c.version, cv.WithBrowser(browser), cv.WithUntrustedSchema(), cv.WithAllowedHosts(allowedHosts...),
+			)
+
+			return api.NewServer(
+				renderer,
+				api.WithMaxBodySize(maxBodySize),
+				api.WithTimeout(timeout),
+			).ListenAndServe(cmd.Context(), address)
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&address,
+		"address", "a", types.DefaultAPIAddress,
+		`The address the server listens on.`,
+	)
+
+	cmd.Flags().Int64Var(
+		&maxBodySize,
+		"max-body-size", types.DefaultAPIMaxBodySize,
+		`The maximum size of the request body in bytes.`,
+	)
+
+	cmd.Flags().DurationVar(
+		&timeout,
+		"timeout", types.DefaultAPITimeout,
+		`The maximum duration of a render.`,
+	)
+
+	cmd.Flags().IntVar(
+		&maxTabs,
+		"max-tabs", types.DefaultAPIMaxTabs,
+		`The maximum number of browser tabs rendering at once. The other renders wait for a free tab.`,
+	)
+
+	cmd.Flags().StringSliceVar(
+		&allowedHosts,
+		"allowed-hosts", nil,
+		`The hosts the schemas can load the remote files from (e.g. templates, extended schemas, and bibliographies).
+The templates of the registry are always allowed.`,
+	)
+
+	chromeFlags.register(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"os"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+// chromeFlags are the flags selecting the headless browser of the commands rendering
+// the PDF and image outputs.
+type chromeFlags struct {
+	url         string
+	execPath    string
+	launchFlags []string
+}
+
+func (f *chromeFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&f.url,
+		"chrome-url", os.Getenv(types.EnvChromeURL),
+		`The DevTools URL of a running Chrome to connect to instead of launching one,
+e.g. ws://127.0.0.1:9222/devtools/browser/<id> or http://127.0.0.1:9222.
+Defaults to the `+types.EnvChromeURL+` environment variable.`,
+	)
+
+	cmd.Flags().StringVar(
+		&f.execPath,
+		"chrome-path", os.Getenv(types.EnvChromeExecPath),
+		`The path to the Chrome or Chromium executable to launch. By default, it is looked up in the
+common locations. Defaults to the `+types.EnvChromeExecPath+` environment variable.`,
+	)
+
+	cmd.Flags().StringArrayVar(
+		&f.launchFlags,
+		"chrome-flag", nil,
+		`An extra command line flag of the Chrome to launch, e.g. --chrome-flag=--no-sandbox.
+Repeat the flag to add multiple flags.`,
+	)
+
+	cmd.MarkFlagsMutuallyExclusive("chrome-url", "chrome-path")
+	cmd.MarkFlagsMutuallyExclusive("chrome-url", "chrome-flag")
+}
+
+func (f *chromeFlags) options() []chrome.Option {
+	var opts []chrome.Option
+
+	if f.url != "" {
+		opts = append(opts, chrome.WithRemoteURL(f.url))
+	}
+
+	if f.execPath != "" {
+		opts = append(opts, chrome.WithExecPath(f.execPath))
+	}
+
+	if len(f.launchFlags) > 0 {
+		opts = append(opts, chrome.WithLaunchFlags(f.launchFlags...))
+	}
+
+	return opts
+}
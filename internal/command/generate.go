@@ -1,7 +1,14 @@
 package command
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/seinshah/civic/internal/cv"
 	"github.com/seinshah/civic/internal/pkg/types"
@@ -11,7 +18,21 @@ import (
 func (c *Command) getGenerateCommands() *cobra.Command {
 	var (
 		schemaFilePath string
-		outputPath     string
+		outputPaths    []string
+		includeTags    []string
+		excludeTags    []string
+		profile        string
+		allProfiles    bool
+		lineWidth      int
+		dpi            int
+		splitPages     bool
+		watch          bool
+		batch          string
+		workers        int
+		noMetadata     bool
+		creationDate   string
+		maxPages       int
+		chromeFlags    chromeFlags
 	)
 
 	cmd := &cobra.Command{
@@ -19,11 +40,62 @@ func (c *Command) getGenerateCommands() *cobra.Command {
 		Short: "Generate the resume or cv",
 		Long:  `Generate the resume or cv based on the schema file and the provided version.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			handler, err := cv.NewHandler(c.version, schemaFilePath, outputPath)
+			opts := []cv.Option{
+				cv.WithTagFilter(types.TagFilter{Include: includeTags, Exclude: excludeTags}),
+				cv.WithProfile(profile),
+				cv.WithLineWidth(lineWidth),
+				cv.WithDPI(dpi),
+				cv.WithPageLimit(maxPages),
+				cv.WithChromeOptions(chromeFlags.options()...),
+			}
+
+			if allProfiles {
+				opts = append(opts, cv.WithAllProfiles())
+			}
+
+			if splitPages {
+				opts = append(opts, cv.WithSplitPages())
+			}
+
+			if noMetadata {
+				opts = append(opts, cv.WithoutPDFMetadata())
+			}
+
+			if creationDate != "" {
+				date, err := parseCreationDate(creationDate)
+				if err != nil {
+					return err
+				}
+
+				opts = append(opts, cv.WithCreationDate(date))
+			}
+
+			var outputPath string
+
+			if len(outputPaths) > 0 {
+				outputPath = outputPaths[0]
+				opts = append(opts, cv.WithOutputs(outputPaths[1:]...))
+			}
+
+			if batch != "" {
+				return c.generateBatch(cmd, batch, outputPath, workers, opts)
+			}
+
+			handler, err := cv.NewHandler(c.version, schemaFilePath, outputPath, opts...)
 			if err != nil {
 				return err
 			}
 
+			defer func() {
+				if err := handler.Close(); err != nil {
+					slog.Warn("Failed to close the headless browser", "error", err)
+				}
+			}()
+
+			if watch {
+				return handler.Watch(cmd.Context())
+			}
+
 			return handler.Generate(cmd.Context())
 		},
 	}
@@ -35,12 +107,167 @@ func (c *Command) getGenerateCommands() *cobra.Command {
 the template. valid types: `+fmt.Sprintf("%v", types.SchemaTypeNames()),
 	)
 
-	cmd.Flags().StringVarP(
-		&outputPath,
-		"output", "o", types.CurrentWDPath(types.DefaultOutputFileName),
+	cmd.Flags().StringArrayVarP(
+		&outputPaths,
+		"output", "o", []string{types.CurrentWDPath(types.DefaultOutputFileName)},
 		`Path to the output file. The output type is inferred from the file extension. valid types:`+
-			fmt.Sprintf("%v", types.OutputTypeNames()),
+			fmt.Sprintf("%v", types.OutputTypeNames())+`
+The path can be a Go template pattern having access to the schema fields and the profile name,
+e.g. "cv-{{.Profile}}.pdf".
+Repeat the flag to generate multiple outputs at once (e.g. -o cv.pdf -o cv.html -o cv.png).`,
+	)
+
+	cmd.Flags().StringSliceVar(
+		&includeTags,
+		"include-tags", nil,
+		`Only keep the tagged entities and detail lines having at least one of these tags.
+Untagged entities and detail lines are always kept.`,
+	)
+
+	cmd.Flags().StringSliceVar(
+		&excludeTags,
+		"exclude-tags", nil,
+		`Remove the entities and detail lines having any of these tags. Exclusion takes precedence over inclusion.`,
+	)
+
+	cmd.Flags().StringVar(
+		&profile,
+		"profile", "",
+		`The name of the profile defined in the schema file to apply on top of the schema.`,
+	)
+
+	cmd.Flags().BoolVar(
+		&allProfiles,
+		"all-profiles", false,
+		`Generate one output per profile defined in the schema file. If the output path is not a pattern,
+the profile name is added before its extension (e.g. civic-backend.pdf).`,
+	)
+
+	cmd.Flags().IntVar(
+		&lineWidth,
+		"line-width", types.DefaultTextLineWidth,
+		`The maximum number of characters in a line of the plain text (txt) output. 0 disables the wrapping.`,
+	)
+
+	cmd.Flags().IntVar(
+		&dpi,
+		"dpi", types.DefaultImageDPI,
+		`The resolution of the image (png, jpg, webp) outputs.`,
+	)
+
+	cmd.Flags().BoolVar(
+		&splitPages,
+		"split-pages", false,
+		`Generate one image per page instead of a single image. Only valid for the image outputs.
+The page number is added before the extension of the output path (e.g. civic-1.png).`,
 	)
 
+	cmd.Flags().BoolVar(
+		&watch,
+		"watch", false,
+		`Keep running and generate the outputs again whenever the schema file, or any local file it
+depends on (e.g. composed schema files, templates, and bibliographies), changes.`,
+	)
+
+	cmd.Flags().StringVar(
+		&batch,
+		"batch", "",
+		`A directory, or a glob pattern (e.g. "cohort/*.yaml"), of schema files to generate the CVs of
+at once instead of a single schema file. The output paths are rendered for each schema file,
+e.g. "{{.Bio.Name}}-{{.Profile}}.pdf". If the output path is not a pattern, the name of the
+schema file is added before its extension (e.g. civic-jane.pdf).`,
+	)
+
+	cmd.Flags().IntVar(
+		&workers,
+		"workers", types.DefaultBatchWorkers,
+		`The maximum number of schema files generated at once in the batch mode.`,
+	)
+
+	cmd.Flags().BoolVar(
+		&noMetadata,
+		"no-pdf-metadata", false,
+		`Do not write the document metadata (author, title, subject, and keywords from the skills)
+and the outline built from the section headers in the PDF outputs.`,
+	)
+
+	cmd.Flags().StringVar(
+		&creationDate,
+		"creation-date", os.Getenv(types.EnvSourceDateEpoch),
+		`The creation date written in the metadata of the PDF outputs, for reproducible builds.
+Either a date (2006-01-02), a timestamp (2006-01-02T15:04:05Z07:00), or a Unix timestamp.
+Defaults to the `+types.EnvSourceDateEpoch+` environment variable, or the current time.`,
+	)
+
+	cmd.Flags().IntVar(
+		&maxPages,
+		"max-pages", 0,
+		`Fail if any PDF output has more pages than this limit, e.g. in a CI pipeline. The PDF is written
+anyway to inspect its layout. Unlike page.maxPages of the schema, the content is not scaled down.
+0 disables the limit.`,
+	)
+
+	chromeFlags.register(cmd)
+
+	cmd.MarkFlagsMutuallyExclusive("profile", "all-profiles")
+	cmd.MarkFlagsMutuallyExclusive("batch", "schema_file")
+	cmd.MarkFlagsMutuallyExclusive("batch", "watch")
+
 	return cmd
 }
+
+// generateBatch generates the CVs of the schema files found by the pattern, and prints
+// the summary of the batch.
+func (c *Command) generateBatch(
+	cmd *cobra.Command,
+	pattern string,
+	outputPath string,
+	workers int,
+	opts []cv.Option,
+) error {
+	schemaFilePaths, err := cv.FindSchemaFiles(pattern)
+	if err != nil {
+		return err
+	}
+
+	results, batchErr := cv.GenerateBatch(cmd.Context(), c.version, schemaFilePaths, outputPath, workers, opts...)
+
+	writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintln(writer, "SCHEMA\tSTATUS\tDURATION\tDETAILS")
+
+	for _, result := range results {
+		status, details := "ok", strings.Join(result.OutputPaths, ", ")
+
+		if result.Err != nil {
+			status, details = "failed", strings.ReplaceAll(result.Err.Error(), "\n", "; ")
+		}
+
+		_, _ = fmt.Fprintf(
+			writer, "%s\t%s\t%s\t%s\n",
+			result.SchemaFilePath, status, result.Duration.Round(time.Millisecond), details,
+		)
+	}
+
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	return batchErr
+}
+
+var errInvalidCreationDate = errors.New("creation date must be a date, a timestamp, or a Unix timestamp")
+
+func parseCreationDate(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %s", errInvalidCreationDate, value)
+}
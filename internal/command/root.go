@@ -47,6 +47,8 @@ easily by separating the template from the content.`,
 
 	cmd.root.AddCommand(
 		cmd.getGenerateCommands(),
+		cmd.getServeCommand(),
+		cmd.getAPICommand(),
 		cmd.getConfigCommands(),
 	)
 
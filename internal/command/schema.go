@@ -1,6 +1,8 @@
 package command
 
 import (
+	"fmt"
+
 	"github.com/seinshah/civic/internal/pkg/types"
 	"github.com/seinshah/civic/internal/schema"
 	"github.com/spf13/cobra"
@@ -15,6 +17,7 @@ func (c *Command) getConfigCommands() *cobra.Command {
 
 	cmd.AddCommand(getSchemaInitCommand())
 	cmd.AddCommand(getSchemaJSONCommand())
+	cmd.AddCommand(getSchemaImportCommand())
 
 	return cmd
 }
@@ -56,3 +59,57 @@ func getSchemaJSONCommand() *cobra.Command {
 
 	return cmd
 }
+
+func getSchemaImportCommand() *cobra.Command {
+	var (
+		inputPath  string
+		from       string
+		outputPath string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import [input]",
+		Short: "Create a CV schema file from a resume in another format.",
+		Long: `Convert an existing resume to a CV schema file. Any field of the resume that
+cannot be mapped to the schema is reported.
+
+Supported formats:
+  jsonresume  a resume.json file following https://jsonresume.org/schema
+  linkedin    the ZIP archive of LinkedIn's "Get a copy of your data" export`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				inputPath = args[0]
+			}
+
+			format, err := types.ParseImportFormat(from)
+			if err != nil {
+				return err
+			}
+
+			handler := schema.NewHandler()
+
+			return handler.Import(cmd.Context(), inputPath, format, outputPath)
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&inputPath,
+		"input", "i", "",
+		`The local path or link to the resume file being imported. It can be provided as an argument as well.`,
+	)
+
+	cmd.Flags().StringVar(
+		&from,
+		"from", types.ImportFormatJsonresume.String(),
+		`The format of the resume being imported. valid formats: `+fmt.Sprintf("%v", types.ImportFormatNames()),
+	)
+
+	cmd.Flags().StringVarP(
+		&outputPath,
+		"output", "o", types.CurrentWDPath(types.DefaultSchemaFileName),
+		`path to the output configuration file.`,
+	)
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/seinshah/civic/internal/preview"
+	"github.com/spf13/cobra"
+)
+
+func (c *Command) getServeCommand() *cobra.Command {
+	var (
+		schemaFilePath string
+		address        string
+		includeTags    []string
+		excludeTags    []string
+		profile        string
+		devMode        bool
+		chromeFlags    chromeFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve a live preview of the resume or cv",
+		Long: `Start a local HTTP server rendering the CV as HTML on every request, and as PDF on /cv.pdf.
+In the development mode, the page reloads itself whenever the schema file or the template changes.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// The PDF requests share a single headless browser, which is launched on the first one,
+			// so the HTML preview needs no browser.
+			browser := chrome.NewLazyBrowser(cmd.Context(), 0, chromeFlags.options()...)
+
+			defer func() {
+				if err := browser.Close(); err != nil {
+					slog.Warn("Failed to close the headless browser", "error", err)
+				}
+			}()
+
+			// The outputs are rendered in memory, so the output path only selects the output type.
+			handler, err := cv.NewHandler(
+				c.version, schemaFilePath, types.DefaultAppName+".html",
+				cv.WithTagFilter(types.TagFilter{Include: includeTags, Exclude: excludeTags}),
+				cv.WithProfile(profile),
+				cv.WithBrowser(browser),
+			)
+			if err != nil {
+				return err
+			}
+
+			var opts []preview.Option
+
+			if devMode {
+				opts = append(opts, preview.WithDevMode())
+			}
+
+			return preview.NewServer(handler, opts...).ListenAndServe(cmd.Context(), address)
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&schemaFilePath,
+		"schema_file", "s", types.CurrentWDPath(types.DefaultSchemaFileName),
+		`The local path or link to the CV schema file. valid types: `+fmt.Sprintf("%v", types.SchemaTypeNames()),
+	)
+
+	cmd.Flags().StringVarP(
+		&address,
+		"address", "a", types.DefaultServeAddress,
+		`The address the server listens on.`,
+	)
+
+	cmd.Flags().StringSliceVar(
+		&includeTags,
+		"include-tags", nil,
+		`Only keep the tagged entities and detail lines having at least one of these tags.`,
+	)
+
+	cmd.Flags().StringSliceVar(
+		&excludeTags,
+		"exclude-tags", nil,
+		`Remove the entities and detail lines having any of these tags.`,
+	)
+
+	cmd.Flags().StringVar(
+		&profile,
+		"profile", "",
+		`The name of the profile defined in the schema file to apply on top of the schema.`,
+	)
+
+	cmd.Flags().BoolVar(
+		&devMode,
+		"dev", false,
+		`Reload the page whenever the schema file, or any local file it depends on, changes.`,
+	)
+
+	chromeFlags.register(cmd)
+
+	return cmd
+}
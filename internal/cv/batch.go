@@ -0,0 +1,187 @@
+package cv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+var (
+	ErrNoSchemaFiles = errors.New("no schema file is found")
+	ErrBatchFailed   = errors.New("failed to generate the CV of some schema files")
+)
+
+// BatchResult is the outcome of generating the outputs of a single schema file in a batch.
+type BatchResult struct {
+	SchemaFilePath string
+	OutputPaths    []string
+	Duration       time.Duration
+	Err            error
+}
+
+// FindSchemaFiles returns the schema files in the directory, or the schema files matching
+// the glob pattern (e.g. "cohort/*.yaml"). The subdirectories and the files of other types
+// are skipped. The files are sorted by their path.
+func FindSchemaFiles(pattern string) ([]string, error) {
+	if info, err := os.Stat(pattern); err == nil && info.IsDir() {
+		pattern = filepath.Join(pattern, "*")
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	files := slices.DeleteFunc(matches, func(path string) bool {
+		info, err := os.Stat(path)
+
+		return err != nil || info.IsDir() || !types.DetectFileType[types.SchemaType](path).IsValid()
+	})
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoSchemaFiles, pattern)
+	}
+
+	slices.Sort(files)
+
+	return files, nil
+}
+
+// GenerateBatch generates the outputs of every schema file, with at most workers schema files
+// being generated at once. A failing schema file does not stop the others, and ErrBatchFailed
+// is returned along with the results if any of them fails. The results are in the order of
+// the schema files.
+//
+// The output paths are rendered for each schema file, so they are expected to be patterns
+// (e.g. "{{.Bio.Name}}.pdf"). Otherwise, the name of the schema file is added before their
+// extension (e.g. civic-jane.pdf). The PDF and image outputs of all the schema files are
+// rendered in a single headless browser.
+func GenerateBatch(
+	ctx context.Context,
+	appVersion string,
+	schemaFilePaths []string,
+	outputPath string,
+	workers int,
+	opts ...Option,
+) ([]BatchResult, error) {
+	results := make([]BatchResult, len(schemaFilePaths))
+	handlers := make([]*Handler, len(schemaFilePaths))
+
+	for i, schemaFilePath := range schemaFilePaths {
+		results[i].SchemaFilePath = schemaFilePath
+
+		name := strings.TrimSuffix(filepath.Base(schemaFilePath), filepath.Ext(schemaFilePath))
+
+		handlers[i], results[i].Err = NewHandler(
+			appVersion, schemaFilePath, outputPath, append(slices.Clone(opts), withBatchName(name))...,
+		)
+	}
+
+	browser, err := newBatchBrowser(ctx, handlers, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	if browser != nil {
+		defer func() {
+			if err := browser.Close(); err != nil {
+				slog.Warn("Failed to close the headless browser", "error", err)
+			}
+		}()
+	}
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range max(workers, 1) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				start := time.Now()
+
+				results[i].OutputPaths, results[i].Err = handlers[i].generateFiles(ctx)
+				results[i].Duration = time.Since(start)
+			}
+		}()
+	}
+
+	for i, handler := range handlers {
+		if handler != nil {
+			jobs <- i
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	failed := 0
+
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return results, fmt.Errorf("%w: %d of %d failed", ErrBatchFailed, failed, len(results))
+	}
+
+	return results, nil
+}
+
+// newBatchBrowser launches the browser shared by the handlers if any of them renders
+// an output in the browser, and no browser is shared already.
+func newBatchBrowser(ctx context.Context, handlers []*Handler, workers int) (*chrome.Browser, error) {
+	var chromeOpts []chrome.Option
+
+	needed := false
+
+	for _, handler := range handlers {
+		if handler == nil || handler.config.browser != nil {
+			continue
+		}
+
+		if slices.ContainsFunc(handler.outputs, func(o outputTarget) bool { return usesBrowser(o.outputType) }) {
+			needed = true
+			chromeOpts = handler.config.chromeOpts
+		}
+	}
+
+	if !needed {
+		return nil, nil //nolint:nilnil
+	}
+
+	browser, err := chrome.NewBrowser(ctx, workers, chromeOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, handler := range handlers {
+		if handler != nil && handler.config.browser == nil {
+			handler.config.browser = browser
+		}
+	}
+
+	return browser, nil
+}
+
+// withBatchName adds the name before the extension of the output paths that are not patterns.
+func withBatchName(name string) Option {
+	return func(o *options) {
+		o.batchName = name
+	}
+}
@@ -0,0 +1,158 @@
+package cv_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSchemaFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"jane.yaml":          "",
+			"john.json":          "",
+			"notes.txt":          "",
+			"nested/alice.toml":  "",
+			"nested.yaml/x.yaml": "",
+		},
+	)
+
+	testCases := []struct {
+		name     string
+		pattern  string
+		expected []string
+		err      error
+	}{
+		{
+			name:     "directory",
+			pattern:  dir,
+			expected: []string{"jane.yaml", "john.json"},
+		},
+		{
+			name:     "glob",
+			pattern:  filepath.Join(dir, "*", "*.toml"),
+			expected: []string{"nested/alice.toml"},
+		},
+		{
+			name:    "no schema file",
+			pattern: filepath.Join(dir, "*.txt"),
+			err:     cv.ErrNoSchemaFiles,
+		},
+		{
+			name:    "missing directory",
+			pattern: filepath.Join(dir, "missing"),
+			err:     cv.ErrNoSchemaFiles,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				files, err := cv.FindSchemaFiles(tc.pattern)
+
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				expected := make([]string, 0, len(tc.expected))
+
+				for _, file := range tc.expected {
+					expected = append(expected, filepath.Join(dir, file))
+				}
+
+				require.Equal(t, expected, files)
+			},
+		)
+	}
+}
+
+func TestGenerateBatch(t *testing.T) {
+	t.Parallel()
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"jane.yaml": `
+template: {path: "<<template_path>>"}
+bio: {name: "Jane Doe", title: "Software Engineer"}
+`,
+			"john.yaml": `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Product Manager"}
+`,
+			"broken.yaml": `bio: [`,
+		},
+	)
+
+	testCases := []struct {
+		name       string
+		outputPath string
+		expected   map[string][]string
+	}{
+		{
+			name:       "plain output path",
+			outputPath: filepath.Join(dir, "plain", "cv.html"),
+			expected: map[string][]string{
+				"jane.yaml": {filepath.Join(dir, "plain", "cv-jane.html")},
+				"john.yaml": {filepath.Join(dir, "plain", "cv-john.html")},
+			},
+		},
+		{
+			name:       "output path pattern",
+			outputPath: filepath.Join(dir, "pattern", "{{.Bio.Name}}.md"),
+			expected: map[string][]string{
+				"jane.yaml": {filepath.Join(dir, "pattern", "Jane Doe.md")},
+				"john.yaml": {filepath.Join(dir, "pattern", "John Doe.md")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				files, err := cv.FindSchemaFiles(dir)
+				require.NoError(t, err)
+
+				results, err := cv.GenerateBatch(t.Context(), "v0.1.0", files, tc.outputPath, 2)
+
+				require.ErrorIs(t, err, cv.ErrBatchFailed)
+				require.Len(t, results, len(files))
+
+				for _, result := range results {
+					name := filepath.Base(result.SchemaFilePath)
+
+					if name == "broken.yaml" {
+						require.Error(t, result.Err)
+						require.Empty(t, result.OutputPaths)
+
+						continue
+					}
+
+					require.NoError(t, result.Err)
+					require.Equal(t, tc.expected[name], result.OutputPaths)
+
+					for _, path := range result.OutputPaths {
+						content, err := os.ReadFile(path)
+
+						require.NoError(t, err)
+						require.NotEmpty(t, content)
+					}
+				}
+			},
+		)
+	}
+}
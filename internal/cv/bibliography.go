@@ -0,0 +1,55 @@
+package cv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/seinshah/civic/internal/pkg/bibliography"
+	"github.com/seinshah/civic/internal/pkg/loader"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+var ErrInvalidBibliography = errors.New("failed to load the publications from the bibliography source")
+
+// loadPublications adds the entries of the bibliography source (if any) to the publication entities.
+// The source is resolved relative to the schema file, as the sources declared by the extended
+// or included files are already resolved relative to them during the composition.
+func (h *Handler) loadPublications(ctx context.Context, publications *types.SchemaPublications) error {
+	if publications == nil || publications.Source == "" {
+		return nil
+	}
+
+	source := loader.ResolvePath(h.schemaFilePath, publications.Source)
+
+	format, err := bibliography.DetectFormat(source)
+	if err != nil {
+		return errors.Join(ErrInvalidBibliography, err)
+	}
+
+	fileLoader, err := h.newLoader(source)
+	if err != nil {
+		return errors.Join(ErrInvalidBibliography, err)
+	}
+
+	content, err := fileLoader.Load(ctx)
+	if err != nil {
+		return errors.Join(ErrInvalidBibliography, err)
+	}
+
+	entries, err := bibliography.Parse(content, format)
+	if err != nil {
+		return errors.Join(ErrInvalidBibliography, fmt.Errorf("%s: %w", source, err))
+	}
+
+	entities, skipped := bibliography.Entities(bibliography.Select(entries, publications.Filter, publications.Sort))
+
+	for _, entry := range skipped {
+		slog.Warn("Skipped the bibliography entry " + entry)
+	}
+
+	publications.Entities = append(publications.Entities, entities...)
+
+	return nil
+}
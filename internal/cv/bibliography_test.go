@@ -0,0 +1,128 @@
+package cv_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Generate_Bibliography(t *testing.T) {
+	t.Parallel()
+
+	bibliography := `
+@article{old, title = {Old Paper}, journal = {Journal}, year = 2015, url = {https://example.com/old}}
+@article{new, title = {New Paper}, journal = {Journal}, year = 2021, month = mar, doi = {10.1000/new}}
+@article{mid, title = {Mid Paper}, journal = {Journal}, year = 2018, doi = {10.1000/mid}}
+@article{draft, title = {Draft}, year = 2022}
+`
+
+	testCases := []struct {
+		name         string
+		publications string
+		// fragment declares the publications in a fragment in a subdirectory instead of the schema file.
+		fragment   bool
+		expected   string
+		unexpected string
+		err        error
+	}{
+		{
+			name:         "source only",
+			publications: `{source: refs/papers.bib}`,
+			expected:     "<li>Old Paper (2015)</li><li>New Paper (2021-03)</li><li>Mid Paper (2018)</li>",
+		},
+		{
+			name: "filter and sort",
+			publications: `{source: refs/papers.bib, sort: newest, filter: {fromYear: 2016},
+  entities: [{title: "Inline Paper", publisher: "Journal", publishDate: "2024", link: "https://example.com"}]}`,
+			expected: "<li>Inline Paper (2024)</li><li>New Paper (2021-03)</li><li>Mid Paper (2018)</li>",
+		},
+		{
+			name:         "keys",
+			publications: `{source: refs/papers.bib, sort: oldest, filter: {keys: [new, old]}}`,
+			expected:     "<li>Old Paper (2015)</li><li>New Paper (2021-03)</li>",
+		},
+		{
+			name:         "source of fragment",
+			publications: `{source: ../refs/papers.bib, filter: {keys: [old]}}`,
+			fragment:     true,
+			expected:     "<li>Old Paper (2015)</li>",
+		},
+		{
+			name:         "no selected entry",
+			publications: `{source: refs/papers.bib, filter: {keys: [draft]}}`,
+			expected:     "<h1>John Doe</h1>",
+			unexpected:   "<h3>Publications</h3>",
+		},
+		{
+			name: "no selected entry with entities",
+			publications: `{source: refs/papers.bib, filter: {keys: [draft]},
+  entities: [{title: "Inline Paper", publisher: "Journal", publishDate: "2024", link: "https://example.com"}]}`,
+			expected: "<h3>Publications</h3><li>Inline Paper (2024)</li>",
+		},
+		{
+			name:         "missing source",
+			publications: `{source: refs/missing.bib}`,
+			err:          cv.ErrInvalidBibliography,
+		},
+		{
+			name:         "unsupported source",
+			publications: `{source: refs/papers.txt}`,
+			err:          cv.ErrInvalidBibliography,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				files := map[string]string{
+					"refs/papers.bib": bibliography,
+					"cv.yaml": `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+publications: ` + tc.publications,
+				}
+
+				if tc.fragment {
+					files["sections/publications.yaml"] = "publications: " + tc.publications
+					files["cv.yaml"] = `
+include: [sections/publications.yaml]
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+`
+				}
+
+				dir := writeComposeFiles(t, files)
+				outputPath := filepath.Join(dir, "output.html")
+
+				h, err := cv.NewHandler("v0.1.0", filepath.Join(dir, "cv.yaml"), outputPath)
+				require.NoError(t, err)
+
+				err = h.Generate(t.Context())
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				content, err := os.ReadFile(filepath.Clean(outputPath))
+
+				require.NoError(t, err)
+				compacted := strings.Join(strings.Fields(string(content)), "")
+
+				require.Contains(t, compacted, strings.Join(strings.Fields(tc.expected), ""))
+
+				if tc.unexpected != "" {
+					require.NotContains(t, compacted, tc.unexpected)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,62 @@
+package cv
+
+import (
+	"context"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// browser returns the browser the PDF and image outputs are rendered in. Unless a browser is
+// provided using WithBrowser, the handler creates one on the first call, which is launched on
+// the first output and kept alive for the next outputs and generations until the handler is closed.
+func (h *Handler) browser(ctx context.Context) *chrome.Browser {
+	if h.config.browser != nil {
+		return h.config.browser
+	}
+
+	h.browserMu.Lock()
+	defer h.browserMu.Unlock()
+
+	if h.ownBrowser == nil {
+		// The browser outlives the generation it is created in (e.g. in the watch mode),
+		// so it is only stopped by closing the handler.
+		h.ownBrowser = chrome.NewLazyBrowser(context.WithoutCancel(ctx), 0, h.config.chromeOpts...)
+	}
+
+	return h.ownBrowser
+}
+
+// Close closes the browser launched by the handler, if any. The browser provided using
+// WithBrowser is left open for its owner to close.
+func (h *Handler) Close() error {
+	h.browserMu.Lock()
+	defer h.browserMu.Unlock()
+
+	if h.ownBrowser == nil {
+		return nil
+	}
+
+	err := h.ownBrowser.Close()
+	h.ownBrowser = nil
+
+	return err
+}
+
+// browserOutputs returns the number of outputs rendered in the browser per profile.
+func (h *Handler) browserOutputs() int {
+	count := 0
+
+	for _, target := range h.outputs {
+		if usesBrowser(target.outputType) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// usesBrowser reports whether the output type is rendered by the headless browser.
+func usesBrowser(outputType types.OutputType) bool {
+	return outputType == types.OutputTypePdf || outputType.IsImage()
+}
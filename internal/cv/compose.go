@@ -0,0 +1,302 @@
+package cv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/loader"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	schemaKeyExtends      = "extends"
+	schemaKeyInclude      = "include"
+	schemaKeyPublications = "publications"
+	schemaKeySource       = "source"
+	schemaKeyTemplate     = "template"
+	schemaKeyLaTeX        = "latex"
+	schemaKeyPath         = "path"
+)
+
+var (
+	ErrSchemaCycle       = errors.New("schema files extend or include each other in a cycle")
+	ErrInvalidSchemaLink = errors.New("invalid extends or include value in the schema file")
+)
+
+// entityListKeys are the keys of the lists that are concatenated during the merge.
+// Any other list is replaced by the value of the file being merged.
+//
+//nolint:gochecknoglobals
+var entityListKeys = []string{"entities", "customSections"}
+
+// schemaComposer resolves the extends and include directives of a schema file.
+// The resolution is recursive, so the base schema and the fragments can extend or include
+// other files themselves.
+//
+// The merge rules are as follow:
+//   - The base schema is the starting point, then the fragments are merged in the order
+//     they are included, and finally the content of the file itself is merged.
+//   - Maps are merged recursively.
+//   - Entity lists (entities of each section and the custom sections) are concatenated.
+//   - Any other value (including other lists) is replaced.
+//   - An explicit null value removes the key from the result (e.g. to drop a whole section).
+type schemaComposer struct {
+	// stack contains the files that are being resolved to detect cycles.
+	stack []string
+
+	newLoader func(path string) (*loader.GeneralLoader, error)
+}
+
+func newSchemaComposer(newLoader func(path string) (*loader.GeneralLoader, error)) *schemaComposer {
+	return &schemaComposer{
+		newLoader: newLoader,
+	}
+}
+
+// compose loads the schema file and resolves all its extends and include directives.
+// It returns the composed content along with its type. If the schema file does not
+// extend or include any file, the original content and type are returned as is.
+func (c *schemaComposer) compose(
+	ctx context.Context,
+	path string,
+	contentType types.SchemaType,
+) ([]byte, types.SchemaType, error) {
+	content, err := c.load(ctx, path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return c.composeContent(ctx, path, content, contentType)
+}
+
+// composeContent resolves the extends and include directives of the schema content loaded
+// from the path. The path is only used to resolve the relative paths, so it can be empty
+// for the content that is not loaded from a file.
+func (c *schemaComposer) composeContent(
+	ctx context.Context,
+	path string,
+	content []byte,
+	contentType types.SchemaType,
+) ([]byte, types.SchemaType, error) {
+	document, err := types.UnmarshalDocument(content, contentType)
+	if err != nil {
+		return nil, "", errors.Join(ErrInvalidSchemaFormat, err)
+	}
+
+	_, hasExtends := document[schemaKeyExtends]
+	_, hasInclude := document[schemaKeyInclude]
+
+	if !hasExtends && !hasInclude {
+		return content, contentType, nil
+	}
+
+	composed, err := c.resolve(ctx, path, document)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// YAML is used as the intermediate format as it is the most forgiving one
+	// when decoding scalars into the schema fields.
+	content, err = yaml.Marshal(composed)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode the composed schema: %w", err)
+	}
+
+	return content, types.SchemaTypeYaml, nil
+}
+
+func (c *schemaComposer) composeFile(ctx context.Context, path string) (map[string]any, error) {
+	contentType := types.DetectFileType[types.SchemaType](path)
+	if !contentType.IsValid() {
+		return nil, fmt.Errorf(
+			"%w: couldn't detect the file type from %s. (valid types: %v)",
+			types.ErrInvalidSchemaType,
+			path,
+			types.SchemaTypeNames(),
+		)
+	}
+
+	content, err := c.load(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := types.UnmarshalDocument(content, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the schema file (%s): %w", path, err)
+	}
+
+	return c.resolve(ctx, path, document)
+}
+
+func (c *schemaComposer) resolve(ctx context.Context, path string, document map[string]any) (map[string]any, error) {
+	key := pathKey(path)
+
+	if slices.Contains(c.stack, key) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrSchemaCycle, strings.Join(c.stack, " -> "), key)
+	}
+
+	c.stack = append(c.stack, key)
+	defer func() {
+		c.stack = c.stack[:len(c.stack)-1]
+	}()
+
+	extends, includes, err := popSchemaLinks(document)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	resolveSourcePaths(path, document)
+
+	// The template paths of the schema file itself are relative to the working directory,
+	// so only the ones of the extended and included files are resolved.
+	if len(c.stack) > 1 {
+		resolveTemplatePaths(path, document)
+	}
+
+	result := make(map[string]any)
+
+	if extends != "" {
+		if result, err = c.composeFile(ctx, loader.ResolvePath(path, extends)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, include := range includes {
+		fragment, err := c.composeFile(ctx, loader.ResolvePath(path, include))
+		if err != nil {
+			return nil, err
+		}
+
+		mergeDocuments(result, fragment)
+	}
+
+	mergeDocuments(result, document)
+
+	return result, nil
+}
+
+func (c *schemaComposer) load(ctx context.Context, path string) ([]byte, error) {
+	schemaLoader, err := c.newLoader(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the schema file (%s): %w", path, err)
+	}
+
+	return schemaLoader.Load(ctx)
+}
+
+// popSchemaLinks removes the extends and include directives from the document
+// and returns their values.
+func popSchemaLinks(document map[string]any) (string, []string, error) {
+	rawExtends, rawInclude := document[schemaKeyExtends], document[schemaKeyInclude]
+
+	delete(document, schemaKeyExtends)
+	delete(document, schemaKeyInclude)
+
+	var (
+		extends  string
+		includes []string
+		ok       bool
+	)
+
+	if rawExtends != nil {
+		if extends, ok = rawExtends.(string); !ok {
+			return "", nil, fmt.Errorf("%w: extends must be a path", ErrInvalidSchemaLink)
+		}
+	}
+
+	switch value := rawInclude.(type) {
+	case nil:
+	case string:
+		includes = []string{value}
+	case []any:
+		for _, item := range value {
+			include, ok := item.(string)
+			if !ok {
+				return "", nil, fmt.Errorf("%w: include must be a list of paths", ErrInvalidSchemaLink)
+			}
+
+			includes = append(includes, include)
+		}
+	default:
+		return "", nil, fmt.Errorf("%w: include must be a list of paths", ErrInvalidSchemaLink)
+	}
+
+	return extends, includes, nil
+}
+
+// resolveSourcePaths resolves the paths the document refers to (i.e. the bibliography source)
+// relative to the file declaring them, as the composed schema is no longer tied to that file.
+// The local paths are made absolute, so resolving them again against the schema file keeps
+// them as is.
+func resolveSourcePaths(path string, document map[string]any) {
+	publications, ok := document[schemaKeyPublications].(map[string]any)
+	if !ok {
+		return
+	}
+
+	if source, ok := publications[schemaKeySource].(string); ok && source != "" {
+		publications[schemaKeySource] = pathKey(loader.ResolvePath(path, source))
+	}
+}
+
+// resolveTemplatePaths resolves the paths of the HTML and LaTeX templates the document refers to
+// relative to the file declaring them, the same way as resolveSourcePaths.
+func resolveTemplatePaths(path string, document map[string]any) {
+	template, ok := document[schemaKeyTemplate].(map[string]any)
+	if !ok {
+		return
+	}
+
+	latex, _ := template[schemaKeyLaTeX].(map[string]any)
+
+	for _, values := range []map[string]any{template, latex} {
+		if ref, ok := values[schemaKeyPath].(string); ok && ref != "" {
+			values[schemaKeyPath] = pathKey(loader.ResolvePath(path, ref))
+		}
+	}
+}
+
+// mergeDocuments deep-merges the source document into the destination document.
+func mergeDocuments(dst map[string]any, src map[string]any) {
+	for key, srcValue := range src {
+		if srcValue == nil {
+			delete(dst, key)
+
+			continue
+		}
+
+		switch value := srcValue.(type) {
+		case map[string]any:
+			if dstMap, ok := dst[key].(map[string]any); ok {
+				mergeDocuments(dstMap, value)
+
+				continue
+			}
+
+		case []any:
+			if dstList, ok := dst[key].([]any); ok && slices.Contains(entityListKeys, key) {
+				dst[key] = append(slices.Clone(dstList), value...)
+
+				continue
+			}
+		}
+
+		dst[key] = srcValue
+	}
+}
+
+// pathKey returns a unique key for the provided path, e.g. to detect the cycles or the
+// duplicate outputs.
+func pathKey(path string) string {
+	if abs, err := filepath.Abs(path); err == nil && !strings.Contains(path, "://") {
+		return abs
+	}
+
+	return path
+}
@@ -0,0 +1,216 @@
+package cv_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/loader"
+	"github.com/stretchr/testify/require"
+)
+
+const composeTestTemplate = `
+<html>
+	<head>
+		<meta name="app-version" content="v0" />
+	</head>
+	<body>
+		<h1>{{.Schema.Bio.Name}}</h1>
+		<h2>{{.Schema.Bio.Title}}</h2>
+		{{with .Schema.WorkExperiences}}{{range .Entities}}<p>{{.Company}}</p>{{end}}{{end}}
+		{{with .Schema.Skills}}<h3>Skills</h3>{{end}}
+		{{with .Schema.Publications}}<h3>{{.Header}}</h3>{{range .Entities}}<li>{{.Title}} ({{.PublishDate}})</li>{{end}}{{end}}
+	</body>
+</html>
+`
+
+// writeComposeFiles writes the provided files in a temporary directory and returns the directory.
+// Any occurrence of <<template_path>> in the files is replaced with the path of a valid template.
+func writeComposeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	templatePath := filepath.Join(dir, "template.html")
+
+	require.NoError(t, os.WriteFile(templatePath, []byte(composeTestTemplate), 0o600))
+
+	for name, content := range files {
+		content = strings.ReplaceAll(content, "<<template_path>>", templatePath)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	return dir
+}
+
+func TestHandler_Generate_Composition(t *testing.T) {
+	t.Parallel()
+
+	baseSchema := `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+workExperiences:
+  entities:
+    - {title: "Engineer", company: "Base Company", startDate: "2019"}
+skills:
+  entities:
+    - {category: "Backend", items: [{name: "Go"}]}
+`
+
+	testCases := []struct {
+		name        string
+		files       map[string]string
+		contains    []string
+		notContains []string
+		err         error
+	}{
+		{
+			name: "extends base schema",
+			files: map[string]string{
+				"base.yaml": baseSchema,
+				"cv.yaml": `
+extends: ./base.yaml
+bio: {title: "Backend Engineer"}
+workExperiences:
+  entities:
+    - {title: "Lead", company: "Child Company", startDate: "2022"}
+`,
+			},
+			contains: []string{
+				"<h1>John Doe</h1>",
+				"<h2>Backend Engineer</h2>",
+				"<p>Base Company</p><p>Child Company</p>",
+				"<h3>Skills</h3>",
+			},
+		},
+		{
+			name: "nested extends and includes in different formats",
+			files: map[string]string{
+				"base/base.yaml": baseSchema,
+				"base/role.json": `{"extends": "base.yaml", "bio": {"title": "Platform Engineer"}}`,
+				"fragments/work.toml": `
+[[workExperiences.entities]]
+title = "Engineer"
+company = "Fragment Company"
+startDate = "2021"
+`,
+				"cv.yaml": `
+extends: base/role.json
+include: [fragments/work.toml]
+workExperiences:
+  entities:
+    - {title: "Lead", company: "Child Company", startDate: "2022"}
+`,
+			},
+			contains: []string{
+				"<h2>Platform Engineer</h2>",
+				"<p>Base Company</p><p>Fragment Company</p><p>Child Company</p>",
+			},
+		},
+		{
+			name: "template path relative to base schema",
+			files: map[string]string{
+				"base/base.yaml": strings.Replace(baseSchema, "<<template_path>>", "../template.html", 1),
+				"cv.yaml":        "extends: base/base.yaml\nbio: {title: \"Backend Engineer\"}\n",
+			},
+			contains: []string{"<h1>John Doe</h1>", "<h2>Backend Engineer</h2>"},
+		},
+		{
+			name: "null removes base section",
+			files: map[string]string{
+				"base.yaml": baseSchema,
+				"cv.yaml":   "extends: base.yaml\nskills: null\n",
+			},
+			contains:    []string{"<p>Base Company</p>"},
+			notContains: []string{"<h3>Skills</h3>"},
+		},
+		{
+			name: "single include",
+			files: map[string]string{
+				"bio.yaml": `bio: {name: "Jane Doe", title: "Data Engineer"}`,
+				"cv.yaml":  "include: bio.yaml\ntemplate: {path: \"<<template_path>>\"}\n",
+			},
+			contains:    []string{"<h1>Jane Doe</h1>"},
+			notContains: []string{"<h3>Skills</h3>"},
+		},
+		{
+			name: "extends cycle",
+			files: map[string]string{
+				"base.yaml": "extends: cv.yaml\n" + baseSchema,
+				"cv.yaml":   "extends: base.yaml\n",
+			},
+			err: cv.ErrSchemaCycle,
+		},
+		{
+			name: "include cycle",
+			files: map[string]string{
+				"fragment.yaml": "include: [cv.yaml]\n",
+				"cv.yaml":       "include: [fragment.yaml]\n" + baseSchema,
+			},
+			err: cv.ErrSchemaCycle,
+		},
+		{
+			name: "invalid include value",
+			files: map[string]string{
+				"cv.yaml": "include: {path: base.yaml}\n" + baseSchema,
+			},
+			err: cv.ErrInvalidSchemaLink,
+		},
+		{
+			name: "non-existent base schema",
+			files: map[string]string{
+				"cv.yaml": "extends: non-existent.yaml\n",
+			},
+			err: loader.ErrInvalidLocalPath,
+		},
+		{
+			name: "invalid composed schema",
+			files: map[string]string{
+				"base.yaml": baseSchema,
+				"cv.yaml":   "extends: base.yaml\nbio: null\n",
+			},
+			err: cv.ErrInvalidSchemaFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				dir := writeComposeFiles(t, tc.files)
+				outputPath := filepath.Join(dir, "output.html")
+
+				h, err := cv.NewHandler("v0.1.0", filepath.Join(dir, "cv.yaml"), outputPath)
+				require.NoError(t, err)
+
+				err = h.Generate(t.Context())
+
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				content, err := os.ReadFile(filepath.Clean(outputPath))
+
+				require.NoError(t, err)
+
+				output := strings.Join(strings.Fields(string(content)), "")
+
+				for _, expected := range tc.contains {
+					require.Contains(t, output, strings.Join(strings.Fields(expected), ""))
+				}
+
+				for _, unexpected := range tc.notContains {
+					require.NotContains(t, output, strings.Join(strings.Fields(unexpected), ""))
+				}
+			},
+		)
+	}
+}
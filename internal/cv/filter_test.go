@@ -0,0 +1,84 @@
+package cv_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Generate_TagFilter(t *testing.T) {
+	t.Parallel()
+
+	schema := `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+workExperiences:
+  entities:
+    - {title: "Engineer", company: "Backend Company", startDate: "2019", tags: [backend]}
+    - {title: "Engineer", company: "Frontend Company", startDate: "2020", tags: [frontend]}
+    - {title: "Intern", company: "Untagged Company", startDate: "2018"}
+skills:
+  entities:
+    - {category: "Frontend", items: [{name: "React", tags: [frontend]}]}
+`
+
+	testCases := []struct {
+		name        string
+		filter      types.TagFilter
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "no filter",
+			contains: []string{"<p>Backend Company</p><p>Frontend Company</p><p>Untagged Company</p>", "<h3>Skills</h3>"},
+		},
+		{
+			name:        "include tags",
+			filter:      types.TagFilter{Include: []string{"backend"}},
+			contains:    []string{"<p>Backend Company</p><p>Untagged Company</p>"},
+			notContains: []string{"Frontend Company", "<h3>Skills</h3>"},
+		},
+		{
+			name:        "exclude tags",
+			filter:      types.TagFilter{Exclude: []string{"backend"}},
+			contains:    []string{"<p>Frontend Company</p><p>Untagged Company</p>", "<h3>Skills</h3>"},
+			notContains: []string{"Backend Company"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				dir := writeComposeFiles(t, map[string]string{"cv.yaml": schema})
+				outputPath := filepath.Join(dir, "output.html")
+
+				h, err := cv.NewHandler(
+					"v0.1.0", filepath.Join(dir, "cv.yaml"), outputPath, cv.WithTagFilter(tc.filter),
+				)
+				require.NoError(t, err)
+				require.NoError(t, h.Generate(t.Context()))
+
+				content, err := os.ReadFile(filepath.Clean(outputPath))
+
+				require.NoError(t, err)
+
+				output := strings.Join(strings.Fields(string(content)), "")
+
+				for _, expected := range tc.contains {
+					require.Contains(t, output, strings.Join(strings.Fields(expected), ""))
+				}
+
+				for _, unexpected := range tc.notContains {
+					require.NotContains(t, output, strings.Join(strings.Fields(unexpected), ""))
+				}
+			},
+		)
+	}
+}
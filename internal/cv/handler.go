@@ -4,41 +4,209 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/seinshah/civic/internal/pkg/output"
+	"github.com/seinshah/civic/internal/pkg/output/docx"
 	"github.com/seinshah/civic/internal/pkg/output/html"
+	"github.com/seinshah/civic/internal/pkg/output/jsonresume"
+	"github.com/seinshah/civic/internal/pkg/output/latex"
+	"github.com/seinshah/civic/internal/pkg/output/markdown"
 	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/output/text"
 	"github.com/seinshah/civic/internal/pkg/types"
 )
 
-var ErrGenerateOutput = errors.New("failed to generate the output")
+var (
+	ErrGenerateOutput  = errors.New("failed to generate the output")
+	ErrProfileConflict = errors.New("a single profile and all profiles cannot be selected together")
+	ErrNoProfiles      = errors.New("schema file does not define any profile")
+	ErrSplitPages      = errors.New("only the image outputs can be split into pages")
+	ErrDuplicateOutput = errors.New("output path is provided more than once")
+	ErrTooManyPages    = errors.New("PDF has more pages than the limit")
+)
 
 type Handler struct {
 	appVersion     string
 	schemaFilePath string
 	schemaType     types.SchemaType
-	outputPath     string
-	outputType     types.OutputType
+	outputs        []outputTarget
+	config         options
+	loadedFiles    *loadedFiles
+
+	// browserMu guards ownBrowser, the browser launched by the handler when no browser is provided.
+	browserMu  sync.Mutex
+	ownBrowser *chrome.Browser
+}
+
+// outputTarget is an output path, or path pattern, and the output type detected from its extension.
+type outputTarget struct {
+	path       string
+	outputType types.OutputType
+}
+
+type options struct {
+	tagFilter   types.TagFilter
+	profile     string
+	allProfiles bool
+	lineWidth   int
+	dpi         int
+	splitPages  bool
+	outputPaths []string
+	noLocal     bool
+	untrusted   bool
+	limitHosts  bool
+	allowHosts  []string
+	browser     *chrome.Browser
+	chromeOpts  []chrome.Option
+	batchName   string
+	noMetadata  bool
+	createdAt   time.Time
+	pageLimit   int
+}
+
+type Option func(*options)
+
+// WithTagFilter prunes the entities and detail lines of the schema based on their tags
+// before rendering the template. Sections that end up empty are removed.
+func WithTagFilter(filter types.TagFilter) Option {
+	return func(o *options) {
+		o.tagFilter = filter
+	}
+}
+
+// WithProfile applies the profile with the provided name on top of the schema.
+func WithProfile(name string) Option {
+	return func(o *options) {
+		o.profile = name
+	}
+}
+
+// WithAllProfiles generates one output per profile defined in the schema.
+// If the output path is not a pattern, the profile name is added before its extension.
+func WithAllProfiles() Option {
+	return func(o *options) {
+		o.allProfiles = true
+	}
+}
+
+// WithLineWidth sets the maximum number of characters in a line of the plain text outputs.
+// 0 disables the wrapping.
+func WithLineWidth(width int) Option {
+	return func(o *options) {
+		o.lineWidth = width
+	}
+}
+
+// WithDPI sets the resolution of the image outputs.
+func WithDPI(dpi int) Option {
+	return func(o *options) {
+		o.dpi = dpi
+	}
+}
+
+// WithSplitPages generates one image per page instead of a single image of the whole CV.
+// The page number is added before the output path extension (e.g. civic-1.png).
+func WithSplitPages() Option {
+	return func(o *options) {
+		o.splitPages = true
+	}
+}
+
+// WithOutputs adds more outputs to be generated along with the main output. The schema and
+// the template are processed once, and the outputs are rendered concurrently.
+func WithOutputs(paths ...string) Option {
+	return func(o *options) {
+		o.outputPaths = append(o.outputPaths, paths...)
+	}
+}
+
+// WithoutLocalFiles prevents the schema from loading any local file (e.g. templates, composed
+// schema files, and bibliographies), which is required when the schema is not trusted.
+func WithoutLocalFiles() Option {
+	return func(o *options) {
+		o.noLocal = true
+	}
+}
+
+// WithUntrustedSchema treats the schema, and the templates and files it refers to, as not
+// trusted (e.g. received over HTTP). The local files cannot be loaded, the templates cannot
+// read the environment variables, and the scripts of the rendered content are not run in
+// the browser.
+func WithUntrustedSchema() Option {
+	return func(o *options) {
+		o.noLocal = true
+		o.untrusted = true
+	}
+}
+
+// WithAllowedHosts only allows loading the remote files (e.g. templates, composed schema files,
+// and bibliographies) from the hosts, and the templates from the template registry.
+// Without any host, only the registry templates are allowed.
+func WithAllowedHosts(hosts ...string) Option {
+	return func(o *options) {
+		o.limitHosts = true
+		o.allowHosts = append(o.allowHosts, hosts...)
+	}
+}
+
+// WithBrowser renders the PDF and image outputs in the tabs of the shared browser
+// instead of launching a browser per output.
+func WithBrowser(browser *chrome.Browser) Option {
+	return func(o *options) {
+		o.browser = browser
+	}
+}
+
+// WithChromeOptions selects the browser the PDF and image outputs are rendered in
+// (e.g. chrome.WithRemoteURL, chrome.WithExecPath, and chrome.WithLaunchFlags).
+func WithChromeOptions(opts ...chrome.Option) Option {
+	return func(o *options) {
+		o.chromeOpts = append(o.chromeOpts, opts...)
+	}
+}
+
+// WithoutPDFMetadata leaves the PDF outputs without the document metadata (e.g. author,
+// title, and keywords) and the outline.
+func WithoutPDFMetadata() Option {
+	return func(o *options) {
+		o.noMetadata = true
+	}
+}
+
+// WithPageLimit fails the generation of the PDF outputs having more pages than the limit.
+// The PDF is written anyway, so its layout can be inspected. Unlike the maximum pages of
+// the schema, the content is not scaled down to fit. 0 disables the limit.
+func WithPageLimit(limit int) Option {
+	return func(o *options) {
+		o.pageLimit = limit
+	}
+}
+
+// WithCreationDate sets the creation date written in the metadata of the PDF outputs, so
+// the same schema results in the same metadata (e.g. for reproducible builds).
+// Default is the time of the generation.
+func WithCreationDate(date time.Time) Option {
+	return func(o *options) {
+		o.createdAt = date
+	}
 }
 
 func NewHandler(
 	appVersion string,
 	schemaFilePath string,
 	outputPath string,
+	opts ...Option,
 ) (*Handler, error) {
-	if outputPath == "" {
-		return nil, types.ErrEmptyOutputPath
-	}
-
-	outputType := types.DetectFileType[types.OutputType](outputPath)
-	if !outputType.IsValid() {
-		return nil, fmt.Errorf(
-			"%w: couldn't detect the file type from %s. (valid types: %v)",
-			types.ErrInvalidOutputType,
-			outputPath,
-			types.OutputTypeNames(),
-		)
+	mainOutput, err := newOutputTarget(outputPath)
+	if err != nil {
+		return nil, err
 	}
 
 	if schemaFilePath == "" {
@@ -55,60 +223,382 @@ func NewHandler(
 		)
 	}
 
+	instanceOpts := options{
+		lineWidth: types.DefaultTextLineWidth,
+		dpi:       types.DefaultImageDPI,
+	}
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	if instanceOpts.allProfiles && instanceOpts.profile != "" {
+		return nil, ErrProfileConflict
+	}
+
+	outputs := []outputTarget{mainOutput}
+
+	for _, path := range instanceOpts.outputPaths {
+		// The output paths that are patterns are compared again once rendered.
+		if slices.ContainsFunc(outputs, func(o outputTarget) bool { return pathKey(o.path) == pathKey(path) }) {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateOutput, path)
+		}
+
+		var target outputTarget
+
+		if target, err = newOutputTarget(path); err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, target)
+	}
+
+	if instanceOpts.splitPages &&
+		!slices.ContainsFunc(outputs, func(o outputTarget) bool { return o.outputType.IsImage() }) {
+		return nil, ErrSplitPages
+	}
+
 	return &Handler{
 		appVersion:     appVersion,
 		schemaFilePath: schemaFilePath,
 		schemaType:     schemaType,
-		outputPath:     outputPath,
-		outputType:     outputType,
+		outputs:        outputs,
+		config:         instanceOpts,
+		loadedFiles:    &loadedFiles{},
 	}, nil
 }
 
+func newOutputTarget(outputPath string) (outputTarget, error) {
+	if outputPath == "" {
+		return outputTarget{}, types.ErrEmptyOutputPath
+	}
+
+	outputType := types.DetectFileType[types.OutputType](outputPath)
+	if !outputType.IsValid() {
+		return outputTarget{}, fmt.Errorf(
+			"%w: couldn't detect the file type from %s. (valid types: %v)",
+			types.ErrInvalidOutputType,
+			outputPath,
+			types.OutputTypeNames(),
+		)
+	}
+
+	return outputTarget{path: outputPath, outputType: outputType}, nil
+}
+
 func (h *Handler) Generate(ctx context.Context) error {
-	confData, err := h.parseSchemaFile(ctx)
+	_, err := h.generateFiles(ctx)
+
+	return err
+}
+
+// generateFiles generates the outputs, and returns the paths of the written files.
+func (h *Handler) generateFiles(ctx context.Context) ([]string, error) {
+	content, contentType, err := h.loadSchemaFile(ctx)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	profiles := []string{h.config.profile}
+
+	if h.config.allProfiles {
+		confData, err := types.NewSchema(content, contentType)
+		if err != nil {
+			return nil, err
+		}
+
+		if profiles = confData.ProfileNames(); len(profiles) == 0 {
+			return nil, ErrNoProfiles
+		}
+	}
+
+	// Launching the browser takes longer than rendering, so the outputs of all the profiles
+	// are rendered in the tabs of the browser of the handler. It is launched up front, so the
+	// outputs do not try to launch it one after another if it cannot be launched.
+	if h.browserOutputs()*len(profiles) > 1 {
+		if err = h.browser(ctx).Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !h.config.allProfiles {
+		return h.generate(ctx, content, contentType, h.config.profile)
+	}
+
+	var paths, profilePaths []string
+
+	for _, profile := range profiles {
+		slog.Info("Generating the CV for profile " + profile)
+
+		profilePaths, err = h.generate(ctx, content, contentType, profile)
+		paths = append(paths, profilePaths...)
+
+		if err != nil {
+			return paths, fmt.Errorf("profile %s: %w", profile, err)
+		}
+	}
+
+	return paths, nil
+}
+
+func (h *Handler) generate(
+	ctx context.Context,
+	content []byte,
+	contentType types.SchemaType,
+	profile string,
+) ([]string, error) {
+	confData, err := h.parseSchema(ctx, content, contentType, profile, nil)
+	if err != nil {
+		return nil, err
 	}
 
 	slog.Info("Successfully processed the CV schema file")
 
-	templateContent, err := h.parseTemplate(
-		ctx, types.TemplateData{
-			Schema: confData,
-		},
+	outputPaths, err := h.getOutputPaths(confData, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each template is rendered once, no matter how many outputs are generated from it.
+	templateContents := make(map[types.OutputType][]byte)
+
+	for _, target := range h.outputs {
+		tplType := templateType(target.outputType)
+		if _, ok := templateContents[tplType]; ok || tplType == "" {
+			continue
+		}
+
+		templateContents[tplType], err = h.parseTemplate(ctx, types.TemplateData{Schema: confData}, tplType)
+		if err != nil {
+			return nil, err
+		}
+
+		slog.Info("Successfully processed the template file")
+	}
+
+	var (
+		wg    sync.WaitGroup
+		paths = make([][]string, len(h.outputs))
+		errs  = make([]error, len(h.outputs))
 	)
+
+	for i, target := range h.outputs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			paths[i], errs[i] = h.generateOutput(
+				ctx, confData, target.outputType, outputPaths[i], templateContents[templateType(target.outputType)],
+			)
+		}()
+	}
+
+	wg.Wait()
+
+	return slices.Concat(paths...), errors.Join(errs...)
+}
+
+// generateOutput renders a single output from the schema or the rendered template,
+// logs whether it succeeded, and returns the paths of the written files.
+func (h *Handler) generateOutput(
+	ctx context.Context,
+	confData *types.Schema,
+	outputType types.OutputType,
+	outputPath string,
+	templateContent []byte,
+) ([]string, error) {
+	paths, err := h.renderOutput(ctx, confData, outputType, templateContent, outputPath)
 	if err != nil {
-		return err
+		slog.Error("Failed to render the output "+outputPath, "error", err)
+
+		return nil, fmt.Errorf("%s: %w", outputPath, errors.Join(ErrGenerateOutput, err))
 	}
 
-	slog.Info("Successfully processed the template file")
+	slog.Info("Rendered the output. Your CV should be ready on " + strings.Join(paths, ", "))
+
+	return paths, nil
+}
+
+// renderOutput renders the output and returns the paths of the written files.
+func (h *Handler) renderOutput(
+	ctx context.Context,
+	confData *types.Schema,
+	outputType types.OutputType,
+	templateContent []byte,
+	outputPath string,
+) ([]string, error) {
+	if schemaGenerator := h.getSchemaGenerator(outputType); schemaGenerator != nil {
+		return []string{outputPath}, output.RenderSchema(ctx, confData, schemaGenerator, outputPath)
+	}
 
-	generator, err := h.getOutputGenerator(confData)
+	generator, err := h.getOutputGenerator(ctx, confData, outputType)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err = output.Render(ctx, templateContent, generator, h.outputPath); err != nil {
-		return errors.Join(ErrGenerateOutput, err)
+	defer closeGenerator(generator)
+
+	if pagedGenerator, ok := generator.(types.PagedOutputGenerator); ok && h.config.splitPages {
+		return output.RenderPages(ctx, templateContent, pagedGenerator, outputPath)
 	}
 
-	slog.Info("Rendered the output. Your CV should be ready on " + h.outputPath)
+	if reportingGenerator, ok := generator.(types.ReportingOutputGenerator); ok {
+		report, err := output.RenderWithReport(ctx, templateContent, reportingGenerator, outputPath)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{outputPath}, h.checkLayout(outputPath, report)
+	}
 
-	return nil
+	return []string{outputPath}, output.Render(ctx, templateContent, generator, outputPath)
+}
+
+// getOutputPaths renders the output paths for the provided profile. Different patterns may be
+// rendered as the same file (e.g. "cv-{{.Profile}}.pdf" and "cv-.pdf" without a profile),
+// which is reported as ErrDuplicateOutput, as the outputs would overwrite each other.
+func (h *Handler) getOutputPaths(confData *types.Schema, profile string) ([]string, error) {
+	outputPaths := make([]string, 0, len(h.outputs))
+
+	for _, target := range h.outputs {
+		outputPath, err := h.getOutputPath(confData, profile, target.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.ContainsFunc(outputPaths, func(path string) bool { return pathKey(path) == pathKey(outputPath) }) {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateOutput, outputPath)
+		}
+
+		outputPaths = append(outputPaths, outputPath)
+	}
+
+	return outputPaths, nil
+}
+
+// getOutputPath renders the output path pattern for the provided profile.
+// If the output path is not a pattern, the batch name and the profile name are added
+// before its extension, so the outputs of the batches and the profiles do not overwrite each other.
+func (h *Handler) getOutputPath(confData *types.Schema, profile string, pattern string) (string, error) {
+	if !types.IsOutputPathPattern(pattern) {
+		ext := filepath.Ext(pattern)
+		base := strings.TrimSuffix(pattern, ext)
+
+		if h.config.batchName != "" {
+			base += "-" + h.config.batchName
+		}
+
+		if h.config.allProfiles {
+			base += "-{{.Profile}}"
+		}
+
+		pattern = base + ext
+	}
+
+	return types.RenderOutputPath(pattern, confData, profile)
+}
+
+// templateType returns the type of the template rendered for the output type, or an empty
+// type if the output is generated directly from the schema.
+func templateType(outputType types.OutputType) types.OutputType {
+	switch outputType {
+	case types.OutputTypePdf, types.OutputTypeHtml, types.OutputTypePng, types.OutputTypeJpg,
+		types.OutputTypeWebp:
+		return types.OutputTypeHtml
+
+	case types.OutputTypeTex:
+		return types.OutputTypeTex
+
+	default:
+		return ""
+	}
+}
+
+// getSchemaGenerator returns the generator of the output types that are generated directly
+// from the schema without rendering the template. It returns nil for other output types.
+//
+//nolint:ireturn
+func (h *Handler) getSchemaGenerator(outputType types.OutputType) types.SchemaGenerator {
+	switch outputType {
+	case types.OutputTypeJson:
+		slog.Debug("Rendering the JSON Resume...")
+
+		return jsonresume.NewEngine()
+
+	case types.OutputTypeDocx:
+		slog.Debug("Rendering the DOCX...")
+
+		return docx.NewEngine()
+
+	case types.OutputTypeMd:
+		slog.Debug("Rendering the Markdown...")
+
+		return markdown.NewEngine()
+
+	case types.OutputTypeTxt:
+		slog.Debug("Rendering the plain text...")
+
+		return text.NewEngine(text.WithLineWidth(h.config.lineWidth))
+
+	default:
+		return nil
+	}
+}
+
+// closeGenerator closes the generator if it holds any resource (e.g. a headless browser).
+func closeGenerator(generator types.OutputGenerator) {
+	closer, ok := generator.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		slog.Warn("Failed to close the output generator", "error", err)
+	}
 }
 
 // getOutputGenerator returns the output generator based on the output type.
 //
 //nolint:ireturn
-func (h *Handler) getOutputGenerator(confData *types.Schema) (types.OutputGenerator, error) {
+func (h *Handler) getOutputGenerator(
+	ctx context.Context,
+	confData *types.Schema,
+	outputType types.OutputType,
+) (types.OutputGenerator, error) {
 	var generator types.OutputGenerator
 
-	switch h.outputType {
+	chromeOpts := h.config.chromeOpts
+	if h.config.untrusted {
+		chromeOpts = append(slices.Clone(chromeOpts), chrome.WithoutJavaScript())
+	}
+
+	switch outputType {
 	case types.OutputTypePdf:
-		generator = chrome.NewHeadless(
+		opts := []chrome.Option{
 			chrome.WithPageSize(confData.Page.Size),
+			chrome.WithPageOrientation(confData.Page.Orientation),
+			chrome.WithCustomPageSize(confData.Page.Width, confData.Page.Height),
 			chrome.WithPageMargin(confData.Page.Margin),
-		)
+			chrome.WithBrowser(h.browser(ctx)),
+		}
+
+		if !h.config.noMetadata {
+			opts = append(opts, chrome.WithMetadata(h.pdfInfo(confData)), chrome.WithDocumentOutline())
+		}
+
+		if confData.Page.MaxPages > 0 {
+			opts = append(opts, chrome.WithMaxPages(confData.Page.MaxPages, confData.Page.MinScale))
+		}
+
+		headerFooterOpts, err := headerFooterOptions(confData)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, headerFooterOpts...)
+
+		generator = chrome.NewHeadless(slices.Concat(chromeOpts, opts)...)
 
 		slog.Debug("Rendering the PDF...")
 
@@ -117,6 +607,28 @@ func (h *Handler) getOutputGenerator(confData *types.Schema) (types.OutputGenera
 
 		slog.Debug("Rendering the HTML...")
 
+	case types.OutputTypePng, types.OutputTypeJpg, types.OutputTypeWebp:
+		generator = chrome.NewScreenshot(
+			slices.Concat(
+				chromeOpts,
+				[]chrome.Option{
+					chrome.WithPageSize(confData.Page.Size),
+					chrome.WithPageOrientation(confData.Page.Orientation),
+					chrome.WithCustomPageSize(confData.Page.Width, confData.Page.Height),
+					chrome.WithDPI(h.config.dpi),
+					chrome.WithImageFormat(outputType),
+					chrome.WithBrowser(h.browser(ctx)),
+				},
+			)...,
+		)
+
+		slog.Debug("Rendering the image...")
+
+	case types.OutputTypeTex:
+		generator = latex.NewEngine()
+
+		slog.Debug("Rendering the LaTeX...")
+
 	default:
 		return nil, types.ErrInvalidOutputType
 	}
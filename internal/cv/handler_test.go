@@ -1,8 +1,10 @@
 package cv_test
 
 import (
+	"archive/zip"
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/seinshah/civic/internal/cv"
 	"github.com/seinshah/civic/internal/pkg/loader"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
 	"github.com/seinshah/civic/internal/pkg/types"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
@@ -115,6 +118,7 @@ func TestNewHandler(t *testing.T) {
 		name           string
 		outputPath     string
 		schemaFilePath string
+		options        []cv.Option
 		hasError       bool
 		err            error
 	}{
@@ -138,10 +142,20 @@ func TestNewHandler(t *testing.T) {
 		{
 			name:           "invalid schema file type",
 			outputPath:     "output.pdf",
-			schemaFilePath: "schema.json",
+			schemaFilePath: "schema.ini",
 			hasError:       true,
 			err:            types.ErrInvalidSchemaType,
 		},
+		{
+			name:           "valid handler with json schema",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.json",
+		},
+		{
+			name:           "valid handler with toml schema",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.toml",
+		},
 		{
 			name:           "valid handler",
 			outputPath:     "output.pdf",
@@ -152,6 +166,50 @@ func TestNewHandler(t *testing.T) {
 			outputPath:     "output.html",
 			schemaFilePath: "schema.yaml",
 		},
+		{
+			name:           "invalid additional output file type",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.yaml",
+			options:        []cv.Option{cv.WithOutputs("output.html", "output.jpeg")},
+			hasError:       true,
+			err:            types.ErrInvalidOutputType,
+		},
+		{
+			name:           "duplicate output",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.yaml",
+			options:        []cv.Option{cv.WithOutputs("output.html", "output.pdf")},
+			hasError:       true,
+			err:            cv.ErrDuplicateOutput,
+		},
+		{
+			name:           "duplicate output after cleaning",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.yaml",
+			options:        []cv.Option{cv.WithOutputs("./output.pdf")},
+			hasError:       true,
+			err:            cv.ErrDuplicateOutput,
+		},
+		{
+			name:           "multiple outputs",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.yaml",
+			options:        []cv.Option{cv.WithOutputs("output.html", "output.png")},
+		},
+		{
+			name:           "split pages of non-image output",
+			outputPath:     "output.pdf",
+			schemaFilePath: "schema.yaml",
+			options:        []cv.Option{cv.WithSplitPages()},
+			hasError:       true,
+			err:            cv.ErrSplitPages,
+		},
+		{
+			name:           "split pages of image output",
+			outputPath:     "output.webp",
+			schemaFilePath: "schema.yaml",
+			options:        []cv.Option{cv.WithSplitPages(), cv.WithDPI(300)},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -159,7 +217,7 @@ func TestNewHandler(t *testing.T) {
 			tc.name, func(t *testing.T) {
 				t.Parallel()
 
-				h, err := cv.NewHandler("v0.1.0", tc.schemaFilePath, tc.outputPath)
+				h, err := cv.NewHandler("v0.1.0", tc.schemaFilePath, tc.outputPath, tc.options...)
 				if tc.hasError {
 					require.Error(t, err)
 					require.Nil(t, h)
@@ -277,6 +335,108 @@ func TestHandler_Generate(t *testing.T) {
 			hasError: true,
 			err:      cv.ErrInvalidDirective,
 		},
+		{
+			name:            "with invalid footer directive",
+			outputExtension: "pdf",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": "<<template_path>>",
+						},
+						"page": map[string]any{
+							"margin": map[string]any{
+								"bottom": 0.5,
+							},
+							"footer": map[string]any{
+								"template": "{{.Schema.SomeInvalidDirective}}",
+							},
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					`
+						<meta name="app-version" content="v0" />
+						<link rel="stylesheet" href="style.css" />
+					`,
+				)
+			},
+			hasError: true,
+			err:      cv.ErrInvalidDirective,
+		},
+		{
+			name:            "with environment function in header",
+			outputExtension: "pdf",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": "<<template_path>>",
+						},
+						"page": map[string]any{
+							"margin": map[string]any{
+								"top": 0.5,
+							},
+							"header": map[string]any{
+								"template": `{{env "PATH"}}`,
+							},
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					`
+						<meta name="app-version" content="v0" />
+						<link rel="stylesheet" href="style.css" />
+					`,
+				)
+			},
+			hasError: true,
+			err:      cv.ErrNonParsableTemplate,
+		},
+		{
+			name:            "with forbidden tag in footer",
+			outputExtension: "pdf",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": "<<template_path>>",
+						},
+						"page": map[string]any{
+							"margin": map[string]any{
+								"bottom": 0.5,
+							},
+							"footer": map[string]any{
+								"template": `<img src="x" /><script>alert(1)</script>`,
+							},
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					`
+						<meta name="app-version" content="v0" />
+						<link rel="stylesheet" href="style.css" />
+					`,
+				)
+			},
+			hasError: true,
+			err:      cv.ErrFoundInvalidTag,
+		},
 		{
 			name: "forbidden html tag link",
 			schemaFilePath: func(t *testing.T) string {
@@ -509,6 +669,190 @@ func TestHandler_Generate(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:            "valid json resume output",
+			outputExtension: "json",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				// The template is not used for JSON Resume outputs, so it is not loaded at all.
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": os.TempDir() + "/non-existent.html",
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					"",
+				)
+			},
+			validateOutput: func(t *testing.T, outputFile string) {
+				t.Helper()
+
+				data, err := os.ReadFile(filepath.Clean(outputFile))
+
+				require.NoError(t, err)
+				require.JSONEq(
+					t,
+					`{
+						"$schema": "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json",
+						"basics": {"name": "John Doe", "label": "Software Engineer"}
+					}`,
+					string(data),
+				)
+			},
+		},
+		{
+			name:            "valid docx output",
+			outputExtension: "docx",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				// The template is not used for DOCX outputs, so it is not loaded at all.
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": os.TempDir() + "/non-existent.html",
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					"",
+				)
+			},
+			validateOutput: func(t *testing.T, outputFile string) {
+				t.Helper()
+
+				archive, err := zip.OpenReader(filepath.Clean(outputFile))
+
+				require.NoError(t, err)
+
+				defer archive.Close()
+
+				document, err := fs.ReadFile(archive, "word/document.xml")
+
+				require.NoError(t, err)
+				require.Contains(t, string(document), "John Doe")
+			},
+		},
+		{
+			name:            "valid plain text output",
+			outputExtension: "txt",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": os.TempDir() + "/non-existent.html",
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					"",
+				)
+			},
+			validateOutput: func(t *testing.T, outputFile string) {
+				t.Helper()
+
+				data, err := os.ReadFile(filepath.Clean(outputFile))
+
+				require.NoError(t, err)
+				require.Equal(t, "JOHN DOE\nSoftware Engineer\n", string(data))
+			},
+		},
+		{
+			name:            "valid tex output",
+			outputExtension: "tex",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": os.TempDir() + "/non-existent.html",
+							"latex": map[string]any{
+								"path": "../../templates/moderncv/v1/template.tex",
+							},
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "R&D Engineer",
+						},
+						"workExperiences": map[string]any{
+							"entities": []map[string]any{
+								{
+									"title":     "Engineer",
+									"company":   "ACME",
+									"startDate": "2019-02",
+									"details":   []string{"Made builds 50% faster"},
+								},
+							},
+						},
+					},
+					"",
+				)
+			},
+			validateOutput: func(t *testing.T, outputFile string) {
+				t.Helper()
+
+				data, err := os.ReadFile(filepath.Clean(outputFile))
+
+				require.NoError(t, err)
+				require.Contains(t, string(data), `\name{John Doe}{}`)
+				require.Contains(t, string(data), `\title{R\&D Engineer}`)
+				require.Contains(t, string(data), `\cventry{2019-02--Present}{Engineer}{ACME}{}{}{`)
+				require.Contains(t, string(data), `\item Made builds 50\% faster`)
+			},
+		},
+		{
+			name:            "valid landscape tex output",
+			outputExtension: "tex",
+			schemaFilePath: func(t *testing.T) string {
+				t.Helper()
+
+				return getSchemaPath(
+					t,
+					map[string]any{
+						"template": map[string]any{
+							"path": os.TempDir() + "/non-existent.html",
+							"latex": map[string]any{
+								"path": "../../templates/moderncv/v1/template.tex",
+							},
+						},
+						"page": map[string]any{
+							"size":        "a4",
+							"orientation": "landscape",
+							"margin":      map[string]any{"top": "1cm"},
+						},
+						"bio": map[string]any{
+							"name":  "John Doe",
+							"title": "Software Engineer",
+						},
+					},
+					"",
+				)
+			},
+			validateOutput: func(t *testing.T, outputFile string) {
+				t.Helper()
+
+				data, err := os.ReadFile(filepath.Clean(outputFile))
+
+				require.NoError(t, err)
+				require.Contains(t, string(data), `paperwidth=11.69in,paperheight=8.27in,top=0.3937007874015748in`)
+			},
+		},
 		{
 			name:            "valid pdf output",
 			outputExtension: "pdf",
@@ -592,3 +936,212 @@ func TestHandler_Generate(t *testing.T) {
 		)
 	}
 }
+
+func TestHandler_Generate_MultipleOutputs(t *testing.T) {
+	t.Parallel()
+
+	latexTemplatePath, err := filepath.Abs("../../templates/moderncv/v1/template.tex")
+	require.NoError(t, err)
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"cv.yaml": `
+template: {path: "<<template_path>>", latex: {path: "` + latexTemplatePath + `"}}
+bio: {name: "John Doe", title: "Software Engineer"}
+`,
+		},
+	)
+
+	testCases := []struct {
+		name      string
+		outputs   []string
+		generated []string
+		err       error
+	}{
+		{
+			name:      "all outputs",
+			outputs:   []string{"all/cv.html", "all/cv.md", "all/cv.tex", "all/cv.json"},
+			generated: []string{"all/cv.html", "all/cv.md", "all/cv.tex", "all/cv.json"},
+		},
+		{
+			name:      "failed output",
+			outputs:   []string{"failed/cv.html", "cv.yaml/cv.md"},
+			generated: []string{"failed/cv.html"},
+			err:       cv.ErrGenerateOutput,
+		},
+		{
+			name:    "same rendered output",
+			outputs: []string{"same/cv-{{.Profile}}.html", "same/cv-.html"},
+			err:     cv.ErrDuplicateOutput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				outputs := make([]string, 0, len(tc.outputs))
+
+				for _, output := range tc.outputs {
+					outputs = append(outputs, filepath.Join(dir, output))
+				}
+
+				h, err := cv.NewHandler(
+					"v0.1.0", filepath.Join(dir, "cv.yaml"), outputs[0], cv.WithOutputs(outputs[1:]...),
+				)
+				require.NoError(t, err)
+
+				err = h.Generate(t.Context())
+
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				for _, generated := range tc.generated {
+					content, err := os.ReadFile(filepath.Join(dir, generated))
+
+					require.NoError(t, err)
+					require.Contains(t, string(content), "John Doe")
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_Render(t *testing.T) {
+	t.Parallel()
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"cv.yaml": `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+`,
+		},
+	)
+
+	testCases := []struct {
+		name       string
+		outputType types.OutputType
+		contains   string
+		err        error
+	}{
+		{
+			name:       "html",
+			outputType: types.OutputTypeHtml,
+			contains:   "<h1>John Doe</h1>",
+		},
+		{
+			name:       "markdown",
+			outputType: types.OutputTypeMd,
+			contains:   "# John Doe",
+		},
+		{
+			name:       "invalid output type",
+			outputType: "doc",
+			err:        types.ErrInvalidOutputType,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				h, err := cv.NewHandler("v0.1.0", filepath.Join(dir, "cv.yaml"), filepath.Join(dir, "cv.html"))
+				require.NoError(t, err)
+
+				content, err := h.Render(t.Context(), tc.outputType)
+
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Contains(t, string(content), tc.contains)
+				require.Contains(t, h.LoadedFiles(), filepath.Join(dir, "cv.yaml"))
+				require.NoFileExists(t, filepath.Join(dir, "cv.html"))
+			},
+		)
+	}
+}
+
+func TestHandler_Generate_SharedBrowser(t *testing.T) {
+	t.Parallel()
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"cv.yaml": `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+`,
+		},
+	)
+
+	// The fake browser records its launches and fails to start, so the generation fails on
+	// the first launch unless the browser is launched per output.
+	launchesPath := filepath.Join(dir, "launches")
+	execPath := filepath.Join(dir, "chrome")
+
+	require.NoError(
+		t,
+		os.WriteFile(execPath, []byte("#!/bin/sh\necho launch >> "+launchesPath+"\nexit 1\n"), 0o700),
+	)
+
+	h, err := cv.NewHandler(
+		"v0.1.0",
+		filepath.Join(dir, "cv.yaml"),
+		filepath.Join(dir, "cv.pdf"),
+		cv.WithOutputs(filepath.Join(dir, "cv.png")),
+		cv.WithChromeOptions(chrome.WithExecPath(execPath)),
+	)
+	require.NoError(t, err)
+	require.Error(t, h.Generate(t.Context()))
+	require.NoError(t, h.Close())
+
+	launches, err := os.ReadFile(launchesPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(launches), "launch"))
+}
+
+func TestHandler_Render_RegistryTemplate(t *testing.T) {
+	t.Parallel()
+
+	templatePath, err := filepath.Abs(fmt.Sprintf("../../templates/genesis/v%d/template.html", types.TemplateVersion))
+	require.NoError(t, err)
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"cv.yaml": `
+template: {path: "` + templatePath + `"}
+page: {orientation: landscape}
+bio: {name: "John Doe", title: "Software Engineer"}
+workExperiences:
+  entities:
+    - {title: "Engineer", company: "Acme", startDate: "2019"}
+publications:
+  entities:
+    - {title: "Paper", publisher: "Journal", publishDate: "2020", link: "https://example.com", authors: ["John Doe"]}
+`,
+		},
+	)
+
+	h, err := cv.NewHandler("v0.1.0", filepath.Join(dir, "cv.yaml"), filepath.Join(dir, "cv.html"))
+	require.NoError(t, err)
+
+	content, err := h.Render(t.Context(), types.OutputTypeHtml)
+
+	require.NoError(t, err)
+	require.Contains(t, string(content), "John Doe")
+	require.Contains(t, string(content), "Acme")
+	require.Contains(t, string(content), `<p class="entity-authors">John Doe</p>`)
+}
@@ -0,0 +1,66 @@
+package cv
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/chrome"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// headerFooterOptions renders the header and the footer templates of the page with
+// the same data as the template, and returns the options for printing them in the PDF.
+func headerFooterOptions(confData *types.Schema) ([]chrome.Option, error) {
+	var opts []chrome.Option
+
+	for _, element := range []struct {
+		config *types.PageHeaderFooter
+		option func(string, bool) chrome.Option
+	}{
+		{confData.Page.Header, chrome.WithHeaderTemplate},
+		{confData.Page.Footer, chrome.WithFooterTemplate},
+	} {
+		if element.config == nil {
+			continue
+		}
+
+		rendered, err := renderHeaderFooter(element.config.Template, types.TemplateData{Schema: confData})
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, element.option(rendered, element.config.SkipFirstPage))
+	}
+
+	return opts, nil
+}
+
+// renderHeaderFooter renders the header or the footer template. As the template comes from
+// the schema, it cannot read the environment variables, and it goes through the same forbidden
+// tag validation as the template.
+func renderHeaderFooter(content string, config types.TemplateData) (string, error) {
+	tpl, err := template.New(types.DefaultAppName).Funcs(htmlFuncs(false)).Parse(content)
+	if err != nil {
+		return "", errors.Join(ErrNonParsableTemplate, err)
+	}
+
+	var output bytes.Buffer
+
+	if err = tpl.Execute(&output, config); err != nil {
+		return "", errors.Join(ErrInvalidDirective, err)
+	}
+
+	_, cursor, err := initiateFlattener(bytes.NewReader(output.Bytes()))
+	if err != nil {
+		return "", errors.Join(ErrNonParsableTemplate, err)
+	}
+
+	validator := &templateValidator{cursor: cursor}
+
+	if err = validator.ValidateForbiddenTags(); err != nil {
+		return "", err
+	}
+
+	return output.String(), nil
+}
@@ -0,0 +1,55 @@
+package cv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// parseLaTeXTemplate renders the LaTeX template using text/template, as html/template
+// would escape the values for HTML. The templates escape the values themselves using
+// the functions provided by types.LaTeXFuncs. The untrusted templates cannot read the
+// environment variables.
+func parseLaTeXTemplate(content []byte, config types.TemplateData, trusted bool) ([]byte, error) {
+	funcs := sprig.TxtFuncMap()
+
+	if !trusted {
+		for _, name := range envFuncs {
+			delete(funcs, name)
+		}
+	}
+
+	for name, fn := range types.DateFuncs() {
+		funcs[name] = fn
+	}
+
+	for name, fn := range types.LaTeXFuncs() {
+		funcs[name] = fn
+	}
+
+	tpl, err := template.New(types.DefaultAppName).Funcs(funcs).Parse(string(content))
+	if err != nil {
+		slog.Debug("", "template", string(content))
+
+		return nil, fmt.Errorf("failed to parse the template: %w", err)
+	}
+
+	var processedTemplate bytes.Buffer
+
+	if err = tpl.Execute(&processedTemplate, config); err != nil {
+		slog.Debug("", "template", string(content), "data", config)
+
+		return nil, errors.Join(ErrInvalidDirective, err)
+	}
+
+	if config.Schema.Template.Customizer.Style != "" {
+		slog.Warn("The customizer style is ignored for the LaTeX templates")
+	}
+
+	return processedTemplate.Bytes(), nil
+}
@@ -0,0 +1,40 @@
+package cv
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// checkLayout logs the layout of the printed output, and fails if it has more pages than
+// the page limit.
+func (h *Handler) checkLayout(outputPath string, report *types.LayoutReport) error {
+	slog.Info(
+		fmt.Sprintf("Printed %d page(s) on %s", report.Pages, outputPath),
+		"pages", report.Pages, "scale", report.Scale,
+	)
+
+	for _, section := range report.SplitSections {
+		slog.Warn(
+			"Section breaks across pages", "output", outputPath, "section", section.Name,
+			"pages", fmt.Sprintf("%d-%d", section.FirstPage, section.LastPage),
+		)
+	}
+
+	for _, overflow := range report.Overflows {
+		slog.Warn(
+			"Element overflows the page width", "output", outputPath, "element", overflow.Element,
+			"overflow", fmt.Sprintf("%.2fin", float64(overflow.Overflow)),
+		)
+	}
+
+	if h.config.pageLimit > 0 && report.Pages > h.config.pageLimit {
+		return fmt.Errorf(
+			"%w: %d pages, which is %d page(s) more than %d",
+			ErrTooManyPages, report.Pages, report.Pages-h.config.pageLimit, h.config.pageLimit,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package cv
+
+import (
+	"slices"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/metadata"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// pdfInfo returns the metadata of the PDF outputs. The keywords are the names of the skills.
+func (h *Handler) pdfInfo(confData *types.Schema) metadata.Info {
+	info := metadata.Info{
+		Title:        confData.Bio.Name,
+		Author:       confData.Bio.Name,
+		Subject:      confData.Bio.Title,
+		Creator:      types.DefaultAppName + " " + h.appVersion,
+		CreationDate: h.config.createdAt,
+	}
+
+	if confData.Skills != nil {
+		for _, entity := range confData.Skills.Entities {
+			for _, item := range entity.Items {
+				if !slices.Contains(info.Keywords, item.Name) {
+					info.Keywords = append(info.Keywords, item.Name)
+				}
+			}
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,142 @@
+package cv_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Generate_Profiles(t *testing.T) {
+	t.Parallel()
+
+	schema := `
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+workExperiences:
+  entities:
+    - {title: "Engineer", company: "Acme", startDate: "2019"}
+profiles:
+  backend:
+    bio: {title: "Backend Engineer"}
+  frontend:
+    bio: {title: "Frontend Engineer"}
+`
+
+	testCases := []struct {
+		name          string
+		outputPath    string
+		options       []cv.Option
+		outputs       map[string]string
+		err           error
+		newHandlerErr error
+	}{
+		{
+			name:       "without profile",
+			outputPath: "cv.html",
+			outputs:    map[string]string{"cv.html": "<h2>Software Engineer</h2>"},
+		},
+		{
+			name:       "single profile",
+			outputPath: "cv.html",
+			options:    []cv.Option{cv.WithProfile("backend")},
+			outputs:    map[string]string{"cv.html": "<h2>Backend Engineer</h2>"},
+		},
+		{
+			name:       "single profile with pattern",
+			outputPath: "{{.Bio.Name}}-{{.Profile}}.html",
+			options:    []cv.Option{cv.WithProfile("frontend")},
+			outputs:    map[string]string{"John Doe-frontend.html": "<h2>Frontend Engineer</h2>"},
+		},
+		{
+			name:       "all profiles",
+			outputPath: "cv.html",
+			options:    []cv.Option{cv.WithAllProfiles()},
+			outputs: map[string]string{
+				"cv-backend.html":  "<h2>Backend Engineer</h2>",
+				"cv-frontend.html": "<h2>Frontend Engineer</h2>",
+			},
+		},
+		{
+			name:       "all profiles with pattern",
+			outputPath: "{{.Profile}}/cv.html",
+			options:    []cv.Option{cv.WithAllProfiles()},
+			outputs: map[string]string{
+				"backend/cv.html":  "<h2>Backend Engineer</h2>",
+				"frontend/cv.html": "<h2>Frontend Engineer</h2>",
+			},
+		},
+		{
+			name:       "unknown profile",
+			outputPath: "cv.html",
+			options:    []cv.Option{cv.WithProfile("unknown")},
+			err:        types.ErrProfileNotFound,
+		},
+		{
+			name:          "conflicting options",
+			outputPath:    "cv.html",
+			options:       []cv.Option{cv.WithProfile("backend"), cv.WithAllProfiles()},
+			newHandlerErr: cv.ErrProfileConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				dir := writeComposeFiles(t, map[string]string{"cv.yaml": schema})
+
+				h, err := cv.NewHandler(
+					"v0.1.0", filepath.Join(dir, "cv.yaml"), filepath.Join(dir, tc.outputPath), tc.options...,
+				)
+
+				if tc.newHandlerErr != nil {
+					require.ErrorIs(t, err, tc.newHandlerErr)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				err = h.Generate(t.Context())
+
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				for path, expected := range tc.outputs {
+					content, err := os.ReadFile(filepath.Join(dir, path))
+
+					require.NoError(t, err)
+					require.Contains(t, string(content), expected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_Generate_NoProfiles(t *testing.T) {
+	t.Parallel()
+
+	dir := writeComposeFiles(
+		t, map[string]string{
+			"cv.yaml": `template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}`,
+		},
+	)
+
+	h, err := cv.NewHandler(
+		"v0.1.0", filepath.Join(dir, "cv.yaml"), filepath.Join(dir, "cv.html"), cv.WithAllProfiles(),
+	)
+
+	require.NoError(t, err)
+	require.ErrorIs(t, h.Generate(t.Context()), cv.ErrNoProfiles)
+}
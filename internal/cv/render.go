@@ -0,0 +1,99 @@
+package cv
+
+import (
+	"context"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// RenderInput is a schema provided in memory (e.g. received over HTTP) to be rendered.
+type RenderInput struct {
+	Content     []byte
+	ContentType types.SchemaType
+	OutputType  types.OutputType
+
+	// Template replaces the template of the schema if provided.
+	Template *types.SchemaTemplate
+}
+
+// NewRenderer creates a handler that only renders the schemas provided in memory using
+// RenderContent. The relative paths in the schemas cannot be resolved, as there is no
+// schema file.
+func NewRenderer(appVersion string, opts ...Option) *Handler {
+	instanceOpts := options{
+		lineWidth: types.DefaultTextLineWidth,
+		dpi:       types.DefaultImageDPI,
+	}
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	return &Handler{
+		appVersion:  appVersion,
+		config:      instanceOpts,
+		loadedFiles: &loadedFiles{},
+	}
+}
+
+// Render renders the CV in memory as the provided output type, using the selected profile (if any).
+// Paged outputs are rendered as a single document.
+func (h *Handler) Render(ctx context.Context, outputType types.OutputType) ([]byte, error) {
+	content, contentType, err := h.loadSchemaFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.render(ctx, content, contentType, outputType, nil)
+}
+
+// RenderContent renders the schema provided in memory, using the selected profile (if any).
+func (h *Handler) RenderContent(ctx context.Context, input RenderInput) ([]byte, error) {
+	if !input.ContentType.IsValid() {
+		return nil, types.ErrInvalidSchemaType
+	}
+
+	content, contentType, err := newSchemaComposer(h.newLoader).composeContent(
+		ctx, h.schemaFilePath, input.Content, input.ContentType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.render(ctx, content, contentType, input.OutputType, input.Template)
+}
+
+func (h *Handler) render(
+	ctx context.Context,
+	content []byte,
+	contentType types.SchemaType,
+	outputType types.OutputType,
+	template *types.SchemaTemplate,
+) ([]byte, error) {
+	if !outputType.IsValid() {
+		return nil, types.ErrInvalidOutputType
+	}
+
+	confData, err := h.parseSchema(ctx, content, contentType, h.config.profile, template)
+	if err != nil {
+		return nil, err
+	}
+
+	if schemaGenerator := h.getSchemaGenerator(outputType); schemaGenerator != nil {
+		return schemaGenerator.GenerateFromSchema(ctx, confData)
+	}
+
+	templateContent, err := h.parseTemplate(ctx, types.TemplateData{Schema: confData}, templateType(outputType))
+	if err != nil {
+		return nil, err
+	}
+
+	generator, err := h.getOutputGenerator(ctx, confData, outputType)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeGenerator(generator)
+
+	return generator.Generate(ctx, templateContent)
+}
@@ -3,30 +3,52 @@ package cv
 import (
 	"context"
 	"errors"
-	"fmt"
 
-	"github.com/seinshah/civic/internal/pkg/loader"
 	"github.com/seinshah/civic/internal/pkg/types"
 )
 
 var ErrInvalidSchemaFormat = errors.New("schema file format does not match the schema")
 
-func (h *Handler) parseSchemaFile(ctx context.Context) (*types.Schema, error) {
-	confLoader, err := loader.NewGeneralLoader(h.schemaFilePath)
+// loadSchemaFile loads the schema file and resolves its extends and include directives.
+func (h *Handler) loadSchemaFile(ctx context.Context) ([]byte, types.SchemaType, error) {
+	return newSchemaComposer(h.newLoader).compose(ctx, h.schemaFilePath, h.schemaType)
+}
+
+// parseSchema parses the schema content, applies the profile (if any), replaces the template
+// (if provided), loads the publications from the bibliography source, applies the tag filter,
+// and validates the final schema.
+func (h *Handler) parseSchema(
+	ctx context.Context,
+	content []byte,
+	contentType types.SchemaType,
+	profile string,
+	template *types.SchemaTemplate,
+) (*types.Schema, error) {
+	data, err := types.NewSchema(content, contentType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load the schema file (%s): %w", h.schemaFilePath, err)
+		return nil, errors.Join(ErrInvalidSchemaFormat, err)
 	}
 
-	content, err := confLoader.Load(ctx)
-	if err != nil {
-		return nil, err
+	if profile != "" {
+		if err = data.ApplyProfile(profile); err != nil {
+			return nil, err
+		}
 	}
 
-	data, err := types.NewSchema(content, h.schemaType)
-	if err != nil {
+	if template != nil {
+		data.Template = *template
+	}
+
+	if err = h.loadPublications(ctx, data.Publications); err != nil {
 		return nil, err
 	}
 
+	if data.Publications != nil && data.Publications.Source != "" && len(data.Publications.Entities) == 0 {
+		data.Publications = nil
+	}
+
+	data.FilterByTags(h.config.tagFilter)
+
 	if err = data.IsValid(); err != nil {
 		return nil, errors.Join(ErrInvalidSchemaFormat, err)
 	}
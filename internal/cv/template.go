@@ -46,18 +46,51 @@ var (
 			"rel": []string{"stylesheet"},
 		},
 	}
+
+	// envFuncs are the template functions reading the environment variables, which are
+	// left out of the untrusted templates.
+	//
+	//nolint: gochecknoglobals
+	envFuncs = []string{"env", "expandenv"}
 )
 
-func (h *Handler) parseTemplate(ctx context.Context, config types.TemplateData) ([]byte, error) {
-	content, err := h.getTemplateContent(ctx, config)
+// htmlFuncs returns the functions available in the HTML templates. The untrusted templates
+// cannot read the environment variables.
+func htmlFuncs(trusted bool) template.FuncMap {
+	funcs := sprig.FuncMap()
+	funcs["unescape"] = types.UnescapeHTML
+
+	for name, fn := range types.DateFuncs() {
+		funcs[name] = fn
+	}
+
+	if !trusted {
+		for _, name := range envFuncs {
+			delete(funcs, name)
+		}
+	}
+
+	return funcs
+}
+
+// parseTemplate renders the template of the provided type, which is either the HTML
+// template (html) or the LaTeX template (tex).
+func (h *Handler) parseTemplate(
+	ctx context.Context,
+	config types.TemplateData,
+	templateType types.OutputType,
+) ([]byte, error) {
+	content, err := h.getTemplateContent(ctx, config, templateType)
 	if err != nil {
 		return nil, err
 	}
 
-	funcs := sprig.FuncMap()
-	funcs["unescape"] = types.UnescapeHTML
+	// LaTeX templates are not HTML documents, so they skip the HTML validations and customizations.
+	if templateType == types.OutputTypeTex {
+		return parseLaTeXTemplate(content, config, !h.config.untrusted)
+	}
 
-	tpl, err := template.New(types.DefaultAppName).Funcs(funcs).Parse(string(content))
+	tpl, err := template.New(types.DefaultAppName).Funcs(htmlFuncs(!h.config.untrusted)).Parse(string(content))
 	if err != nil {
 		slog.Debug("", "template", string(content))
 
@@ -94,33 +127,40 @@ func (h *Handler) parseTemplate(ctx context.Context, config types.TemplateData)
 	return output.Bytes(), nil
 }
 
-func (h *Handler) getTemplateContent(ctx context.Context, config types.TemplateData) ([]byte, error) {
-	if config.Schema.Template.Path == "" && config.Schema.Template.Name == "" {
-		return nil, ErrTemplateNotProvided
-	}
+func (h *Handler) getTemplateContent(
+	ctx context.Context,
+	config types.TemplateData,
+	templateType types.OutputType,
+) ([]byte, error) {
+	templatePath, templateName, fileName := config.Schema.Template.Path, config.Schema.Template.Name, "template.html"
 
-	var templatePath string
+	if templateType == types.OutputTypeTex {
+		templatePath, templateName, fileName = config.Schema.Template.LaTeX.Path,
+			config.Schema.Template.LaTeX.Name, "template.tex"
 
-	if config.Schema.Template.Path != "" {
-		templatePath = config.Schema.Template.Path
-	} else if config.Schema.Template.Name != "" {
-		appV, err := version.Parse(h.appVersion)
-		if err != nil {
-			return nil, fmt.Errorf("invalid app version: %w", err)
+		if templatePath == "" && templateName == "" {
+			templateName = types.DefaultLaTeXTemplateName
 		}
+	}
+
+	if templatePath == "" && templateName == "" {
+		return nil, ErrTemplateNotProvided
+	}
 
+	if templatePath == "" {
 		templatePath = fmt.Sprintf(
-			"%s/%s/v%d/template.html",
+			"%s/%s/v%d/%s",
 			types.TemplateRegistryPath,
-			config.Schema.Template.Name,
-			appV.Major(),
+			templateName,
+			types.TemplateVersion,
+			fileName,
 		)
 	}
 
-	templateLoader, err := loader.NewGeneralLoader(templatePath)
+	templateLoader, err := h.newLoader(templatePath)
 	if err != nil {
 		if !errors.Is(err, loader.ErrInvalidPath) {
-			return nil, fmt.Errorf("failed to load template file (%s): %w", config.Schema.Template.Path, err)
+			return nil, fmt.Errorf("failed to load template file (%s): %w", templatePath, err)
 		}
 	}
 
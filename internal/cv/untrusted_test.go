@@ -0,0 +1,87 @@
+package cv_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_RenderContent_Untrusted(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.FileServerFS(fstest.MapFS{
+		"template.html": {Data: []byte(composeTestTemplate)},
+		"env.html":      {Data: []byte(strings.Replace(composeTestTemplate, "<h1>", `<h1>{{env "PATH"}}`, 1))},
+		"base.yaml":     {Data: []byte(`bio: {name: "John Doe", title: "Software Engineer"}`)},
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name     string
+		template string
+		options  []cv.Option
+		contains string
+		err      error
+		errText  string
+	}{
+		{
+			name:     "allowed host",
+			template: "template.html",
+			options:  []cv.Option{cv.WithAllowedHosts("127.0.0.1")},
+			contains: "<h1>John Doe</h1>",
+		},
+		{
+			name:     "host not allowed",
+			template: "template.html",
+			options:  []cv.Option{cv.WithAllowedHosts("example.com")},
+			err:      cv.ErrRemoteHostNotAllowed,
+		},
+		{
+			name:     "no allowed host",
+			template: "template.html",
+			options:  []cv.Option{cv.WithAllowedHosts()},
+			err:      cv.ErrRemoteHostNotAllowed,
+		},
+		{
+			name:     "environment function",
+			template: "env.html",
+			errText:  `function "env" not defined`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				renderer := cv.NewRenderer("v0.1.0", append([]cv.Option{cv.WithUntrustedSchema()}, tc.options...)...)
+
+				content, err := renderer.RenderContent(
+					t.Context(),
+					cv.RenderInput{
+						Content:     []byte("extends: " + server.URL + "/base.yaml\n"),
+						ContentType: types.SchemaTypeYaml,
+						OutputType:  types.OutputTypeHtml,
+						Template:    &types.SchemaTemplate{Path: server.URL + "/" + tc.template},
+					},
+				)
+
+				switch {
+				case tc.err != nil:
+					require.ErrorIs(t, err, tc.err)
+				case tc.errText != "":
+					require.ErrorContains(t, err, tc.errText)
+				default:
+					require.NoError(t, err)
+					require.Contains(t, string(content), tc.contains)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,142 @@
+package cv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/seinshah/civic/internal/pkg/loader"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/seinshah/civic/internal/pkg/watcher"
+)
+
+var (
+	ErrLocalFileNotAllowed  = errors.New("loading local files is not allowed")
+	ErrRemoteHostNotAllowed = errors.New("loading remote files from this host is not allowed")
+)
+
+// loadedFiles records the local files loaded while generating the outputs
+// (e.g. the composed schema files, the templates, and the bibliographies).
+type loadedFiles struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (f *loadedFiles) add(path string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+
+	if !slices.Contains(f.paths, path) {
+		f.paths = append(f.paths, path)
+	}
+}
+
+func (f *loadedFiles) list() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.paths)
+}
+
+// reset clears the recorded files and returns them.
+func (f *loadedFiles) reset() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	paths := f.paths
+	f.paths = nil
+
+	return paths
+}
+
+// newLoader creates the loader of the file and records the file if it is a local one.
+// Local files, and remote files from hosts that are not allowed, are rejected.
+func (h *Handler) newLoader(path string) (*loader.GeneralLoader, error) {
+	fileLoader, err := loader.NewGeneralLoader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch fileLoader.Loader().(type) {
+	case *loader.LocalLoader:
+		if h.config.noLocal {
+			return nil, fmt.Errorf("%w: %s", ErrLocalFileNotAllowed, path)
+		}
+
+		h.loadedFiles.add(path)
+
+	case *loader.RemoteLoader:
+		if h.config.limitHosts && !h.isAllowedRemote(path) {
+			return nil, fmt.Errorf("%w: %s", ErrRemoteHostNotAllowed, path)
+		}
+	}
+
+	return fileLoader, nil
+}
+
+// isAllowedRemote reports whether the remote file is a template of the registry, or is
+// hosted by one of the allowed hosts.
+func (h *Handler) isAllowedRemote(link string) bool {
+	remote, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	registry, err := url.Parse(types.TemplateRegistryPath)
+	if err != nil {
+		return false
+	}
+
+	// The path is cleaned, so it cannot leave the registry using the dot segments.
+	if remote.Scheme == registry.Scheme && remote.Host == registry.Host &&
+		strings.HasPrefix(path.Clean(remote.Path), registry.Path+"/") {
+		return true
+	}
+
+	return slices.ContainsFunc(h.config.allowHosts, func(host string) bool {
+		return strings.EqualFold(host, remote.Hostname())
+	})
+}
+
+// LoadedFiles returns the local files loaded while generating the outputs so far,
+// including the schema file.
+func (h *Handler) LoadedFiles() []string {
+	return h.loadedFiles.list()
+}
+
+// Watch generates the outputs, and generates them again whenever the schema file or any
+// local file loaded during the generation changes. The headless browser of the handler is kept
+// alive between the generations. It blocks until the context is done.
+func (h *Handler) Watch(ctx context.Context, opts ...watcher.Option) error {
+	h.regenerate(ctx)
+
+	return watcher.New(opts...).Watch(ctx, h.loadedFiles.list, h.regenerate)
+}
+
+// regenerate generates the outputs and logs the failure, so the watch goes on.
+func (h *Handler) regenerate(ctx context.Context) {
+	previous := h.loadedFiles.reset()
+
+	if err := h.Generate(ctx); err != nil {
+		// The generation may fail before loading all the files (e.g. while an editor replaces
+		// the schema file), so the files watched before are kept until it succeeds again.
+		for _, path := range previous {
+			h.loadedFiles.add(path)
+		}
+
+		slog.Error("Failed to generate the CV", "error", err)
+	}
+
+	slog.Info("Watching for changes. Press Ctrl+C to stop.")
+}
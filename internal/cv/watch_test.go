@@ -0,0 +1,117 @@
+package cv_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seinshah/civic/internal/cv"
+	"github.com/seinshah/civic/internal/pkg/watcher"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Watch(t *testing.T) {
+	t.Parallel()
+
+	dir := writeComposeFiles(
+		t,
+		map[string]string{
+			"cv.yaml": `
+include: [work.yaml]
+template: {path: "<<template_path>>"}
+bio: {name: "John Doe", title: "Software Engineer"}
+`,
+			"work.yaml": `
+workExperiences:
+  entities:
+    - {title: "Engineer", company: "First Company", startDate: "2019"}
+`,
+		},
+	)
+
+	outputPath := filepath.Join(dir, "output.html")
+
+	h, err := cv.NewHandler("v0.1.0", filepath.Join(dir, "cv.yaml"), outputPath)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- h.Watch(ctx, watcher.WithInterval(10*time.Millisecond), watcher.WithDebounce(50*time.Millisecond))
+	}()
+
+	waitForOutput := func(expected string) {
+		t.Helper()
+
+		require.Eventually(
+			t,
+			func() bool {
+				content, err := os.ReadFile(filepath.Clean(outputPath))
+
+				return err == nil && strings.Contains(string(content), expected)
+			},
+			5*time.Second,
+			20*time.Millisecond,
+		)
+	}
+
+	waitForOutput("First Company")
+
+	// The included file is watched along with the schema file.
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dir, "work.yaml"),
+			[]byte(`{workExperiences: {entities: [{title: "Engineer", company: "Second Company", startDate: "2020"}]}}`),
+			0o600,
+		),
+	)
+
+	waitForOutput("Second Company")
+
+	// The template is watched as well.
+	template, err := os.ReadFile(filepath.Join(dir, "template.html"))
+	require.NoError(t, err)
+
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dir, "template.html"),
+			[]byte(strings.Replace(string(template), "<h1>", "<h1>Watched ", 1)),
+			0o600,
+		),
+	)
+
+	waitForOutput("Watched John Doe")
+
+	// The files are still watched after the generation fails, as the schema file is missing
+	// while it is being replaced.
+	watched := h.LoadedFiles()
+
+	schema, err := os.ReadFile(filepath.Join(dir, "cv.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(dir, "cv.yaml")))
+
+	time.Sleep(200 * time.Millisecond)
+
+	require.ElementsMatch(t, watched, h.LoadedFiles())
+
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dir, "cv.yaml"),
+			[]byte(strings.Replace(string(schema), "John Doe", "Jane Doe", 1)),
+			0o600,
+		),
+	)
+
+	waitForOutput("Watched Jane Doe")
+
+	cancel()
+
+	require.NoError(t, <-done)
+}
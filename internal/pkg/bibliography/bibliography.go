@@ -0,0 +1,109 @@
+// Package bibliography parses bibliography files (BibTeX and CSL-JSON) into entries
+// that can be listed as publications in the CV.
+package bibliography
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// Format is the format of a bibliography file.
+type Format string
+
+const (
+	FormatBibTeX  Format = "bibtex"
+	FormatCSLJSON Format = "csl-json"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported bibliography format (valid extensions: .bib, .bibtex, .json)")
+	ErrInvalidContent    = errors.New("bibliography file cannot be parsed")
+)
+
+// Entry is a single publication of a bibliography.
+type Entry struct {
+	// Key is the citation key (BibTeX) or the id (CSL-JSON) of the entry.
+	Key string
+
+	Title string
+
+	// Authors are the full names of the authors in the "Given Family" order.
+	Authors []string
+
+	// Publisher is the journal, the proceedings, or the publisher of the entry,
+	// whichever is available in this order.
+	Publisher string
+
+	Year  int
+	Month int
+	Day   int
+
+	// Link is the DOI link of the entry if available, or its URL otherwise.
+	Link string
+}
+
+// DetectFormat detects the format of the bibliography file from its extension.
+func DetectFormat(path string) (Format, error) {
+	switch types.DetectFileType[string](path) {
+	case "bib", "bibtex":
+		return FormatBibTeX, nil
+	case "json":
+		return FormatCSLJSON, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+}
+
+// Parse parses the content of a bibliography file in the provided format.
+func Parse(content []byte, format Format) ([]Entry, error) {
+	switch format {
+	case FormatBibTeX:
+		return ParseBibTeX(content)
+	case FormatCSLJSON:
+		return ParseCSLJSON(content)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+}
+
+// Date returns the publication date of the entry in the most precise
+// ISO 8601 form available (e.g. 2021, 2021-03, or 2021-03-15).
+func (e Entry) Date() types.Date {
+	switch {
+	case e.Year == 0:
+		return ""
+	case e.Month == 0:
+		return types.Date(fmt.Sprintf("%04d", e.Year))
+	case e.Day == 0:
+		return types.Date(fmt.Sprintf("%04d-%02d", e.Year, e.Month))
+	}
+
+	return types.Date(fmt.Sprintf("%04d-%02d-%02d", e.Year, e.Month, e.Day))
+}
+
+// doiLink normalizes a DOI (e.g. 10.1000/xyz or doi:10.1000/xyz) to its resolver link.
+func doiLink(doi string) string {
+	doi = strings.TrimSpace(doi)
+	if doi == "" {
+		return ""
+	}
+
+	if strings.HasPrefix(doi, "http://") || strings.HasPrefix(doi, "https://") {
+		return doi
+	}
+
+	return "https://doi.org/" + strings.TrimPrefix(strings.TrimPrefix(doi, "doi:"), "DOI:")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,240 @@
+package bibliography_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/bibliography"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	//go:embed testdata/refs.bib
+	sampleBibTeX []byte
+
+	//go:embed testdata/refs.json
+	sampleCSLJSON []byte
+)
+
+func TestDetectFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		path     string
+		expected bibliography.Format
+		err      error
+	}{
+		{path: "refs.bib", expected: bibliography.FormatBibTeX},
+		{path: "https://example.com/refs.BIBTEX", expected: bibliography.FormatBibTeX},
+		{path: "refs.json", expected: bibliography.FormatCSLJSON},
+		{path: "refs.ris", err: bibliography.ErrUnsupportedFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.path, func(t *testing.T) {
+				t.Parallel()
+
+				format, err := bibliography.DetectFormat(tc.path)
+
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, tc.expected, format)
+			},
+		)
+	}
+}
+
+func TestParseBibTeX(t *testing.T) {
+	t.Parallel()
+
+	entries, err := bibliography.ParseBibTeX(sampleBibTeX)
+
+	require.NoError(t, err)
+	require.Equal(
+		t, []bibliography.Entry{
+			{
+				Key:       "doe2016sql",
+				Title:     "A Novel Approach for Detecting SQL Injection Attacks",
+				Authors:   []string{"John Doe", "Jérôme Müller", "Barnes and Noble"},
+				Publisher: "IEEE Transactions on Software Engineering",
+				Year:      2016,
+				Month:     9,
+				Link:      "https://doi.org/10.1109/TSE.2016.1234567",
+			},
+			{
+				Key:       "doe2020go",
+				Title:     "Concurrency Patterns in Go",
+				Authors:   []string{"John Doe", "et al."},
+				Publisher: "Proceedings of the Conference on Systems",
+				Year:      2020,
+				Month:     3,
+				Day:       15,
+				Link:      "https://example.com/go.pdf",
+			},
+			{
+				Key:     "doe2018draft",
+				Title:   "An Unpublished Draft",
+				Authors: []string{"John Doe Jr"},
+				Year:    2018,
+			},
+		}, entries,
+	)
+}
+
+func TestParseBibTeX_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+	}{
+		{name: "unterminated entry", content: `@article{key, title = {Title}`},
+		{name: "unterminated value", content: `@article{key, title = {Title`},
+		{name: "undefined string", content: `@article{key, journal = unknown}`},
+		{name: "missing equal sign", content: `@article{key, title {Title}}`},
+		{name: "missing delimiter", content: `@article key`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := bibliography.ParseBibTeX([]byte(tc.content))
+
+				require.ErrorIs(t, err, bibliography.ErrInvalidContent)
+			},
+		)
+	}
+}
+
+func TestParseCSLJSON(t *testing.T) {
+	t.Parallel()
+
+	entries, err := bibliography.ParseCSLJSON(sampleCSLJSON)
+
+	require.NoError(t, err)
+	require.Equal(
+		t, []bibliography.Entry{
+			{
+				Key:       "doe2016sql",
+				Title:     "A Novel Approach for Detecting SQL Injection Attacks",
+				Authors:   []string{"John Doe", "Ludwig van Beethoven", "Barnes and Noble"},
+				Publisher: "IEEE Transactions on Software Engineering",
+				Year:      2016,
+				Month:     9,
+				Link:      "https://doi.org/10.1109/TSE.2016.1234567",
+			},
+			{
+				Key:       "42",
+				Title:     "Concurrency Patterns in Go",
+				Publisher: "ACM",
+				Year:      2020,
+				Month:     3,
+				Day:       15,
+				Link:      "https://example.com/go.pdf",
+			},
+		}, entries,
+	)
+
+	_, err = bibliography.ParseCSLJSON([]byte(`{"id": "not a list"}`))
+
+	require.ErrorIs(t, err, bibliography.ErrInvalidContent)
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	entries := []bibliography.Entry{
+		{Key: "b", Title: "beta", Year: 2018},
+		{Key: "a", Title: "Alpha", Year: 2020, Month: 3},
+		{Key: "c", Title: "Gamma", Year: 2020, Month: 1},
+		{Key: "d", Title: "delta", Year: 2015},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   *types.SchemaPublicationsFilter
+		order    types.PublicationSort
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			expected: []string{"b", "a", "c", "d"},
+		},
+		{
+			name:     "keys",
+			filter:   &types.SchemaPublicationsFilter{Keys: []string{"d", "a"}},
+			order:    types.PublicationSortNone,
+			expected: []string{"a", "d"},
+		},
+		{
+			name:     "year range",
+			filter:   &types.SchemaPublicationsFilter{FromYear: 2016, ToYear: 2019},
+			expected: []string{"b"},
+		},
+		{
+			name:     "newest",
+			filter:   &types.SchemaPublicationsFilter{FromYear: 2016},
+			order:    types.PublicationSortNewest,
+			expected: []string{"a", "c", "b"},
+		},
+		{
+			name:     "oldest",
+			order:    types.PublicationSortOldest,
+			expected: []string{"d", "b", "c", "a"},
+		},
+		{
+			name:     "title",
+			order:    types.PublicationSortTitle,
+			expected: []string{"a", "b", "d", "c"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				var keys []string
+
+				for _, entry := range bibliography.Select(entries, tc.filter, tc.order) {
+					keys = append(keys, entry.Key)
+				}
+
+				require.Equal(t, tc.expected, keys)
+			},
+		)
+	}
+}
+
+func TestEntities(t *testing.T) {
+	t.Parallel()
+
+	entries, err := bibliography.ParseBibTeX(sampleBibTeX)
+
+	require.NoError(t, err)
+
+	entities, skipped := bibliography.Entities(entries)
+
+	require.Equal(
+		t, []types.SchemaPublicationsEntity{
+			{
+				Title:       "A Novel Approach for Detecting SQL Injection Attacks",
+				Publisher:   "IEEE Transactions on Software Engineering",
+				PublishDate: "2016-09",
+				Link:        "https://doi.org/10.1109/TSE.2016.1234567",
+				Authors:     []string{"John Doe", "Jérôme Müller", "Barnes and Noble"},
+			},
+			{
+				Title:       "Concurrency Patterns in Go",
+				Publisher:   "Proceedings of the Conference on Systems",
+				PublishDate: "2020-03-15",
+				Link:        "https://example.com/go.pdf",
+				Authors:     []string{"John Doe", "et al."},
+			},
+		}, entities,
+	)
+	require.Equal(t, []string{"doe2018draft (missing publisher, doi or url)"}, skipped)
+}
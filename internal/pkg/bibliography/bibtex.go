@@ -0,0 +1,567 @@
+package bibliography
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// bibtexMonths are the predefined month macros of BibTeX.
+//
+//nolint:gochecknoglobals
+var bibtexMonths = map[string]string{
+	"jan": "January", "feb": "February", "mar": "March", "apr": "April",
+	"may": "May", "jun": "June", "jul": "July", "aug": "August",
+	"sep": "September", "oct": "October", "nov": "November", "dec": "December",
+}
+
+// ParseBibTeX parses the entries of a BibTeX (or BibLaTeX) file.
+// @string macros and the # concatenation are supported, while @comment and
+// @preamble blocks are ignored.
+func ParseBibTeX(content []byte) ([]Entry, error) {
+	parser := &bibtexParser{
+		input:  []rune(string(content)),
+		macros: make(map[string]string, len(bibtexMonths)),
+	}
+
+	for name, month := range bibtexMonths {
+		parser.macros[name] = month
+	}
+
+	entries, err := parser.parse()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidContent, err)
+	}
+
+	return entries, nil
+}
+
+type bibtexParser struct {
+	input  []rune
+	pos    int
+	macros map[string]string
+}
+
+func (p *bibtexParser) parse() ([]Entry, error) {
+	var entries []Entry
+
+	for {
+		// Anything outside of the entries is a comment.
+		for p.pos < len(p.input) && p.input[p.pos] != '@' {
+			p.pos++
+		}
+
+		if p.pos >= len(p.input) {
+			return entries, nil
+		}
+
+		p.pos++
+
+		kind := strings.ToLower(p.identifier())
+
+		p.skipSpaces()
+
+		closing, err := p.openEntry()
+		if err != nil {
+			return nil, err
+		}
+
+		switch kind {
+		case "comment", "preamble":
+			if err = p.skipEntry(closing); err != nil {
+				return nil, err
+			}
+		case "string":
+			fields, err := p.fields(closing)
+			if err != nil {
+				return nil, err
+			}
+
+			for name, value := range fields {
+				p.macros[name] = value
+			}
+		default:
+			entry, err := p.entry(closing)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+}
+
+// openEntry consumes the opening delimiter of an entry and returns its closing delimiter.
+func (p *bibtexParser) openEntry() (rune, error) {
+	if p.pos >= len(p.input) {
+		return 0, p.errorf("unexpected end of file")
+	}
+
+	switch p.input[p.pos] {
+	case '{':
+		p.pos++
+
+		return '}', nil
+	case '(':
+		p.pos++
+
+		return ')', nil
+	}
+
+	return 0, p.errorf("expected { or ( but found %q", p.input[p.pos])
+}
+
+func (p *bibtexParser) skipEntry(closing rune) error {
+	depth := 0
+
+	for ; p.pos < len(p.input); p.pos++ {
+		switch char := p.input[p.pos]; {
+		case char == '{':
+			depth++
+		case char == '}' && depth > 0:
+			depth--
+		case char == closing && depth == 0:
+			p.pos++
+
+			return nil
+		}
+	}
+
+	return p.errorf("unexpected end of file")
+}
+
+func (p *bibtexParser) entry(closing rune) (Entry, error) {
+	p.skipSpaces()
+
+	start := p.pos
+
+	for p.pos < len(p.input) && p.input[p.pos] != ',' && p.input[p.pos] != closing {
+		p.pos++
+	}
+
+	key := strings.TrimSpace(string(p.input[start:p.pos]))
+
+	if p.pos < len(p.input) && p.input[p.pos] == ',' {
+		p.pos++
+	}
+
+	fields, err := p.fields(closing)
+	if err != nil {
+		return Entry{}, fmt.Errorf("%s: %w", key, err)
+	}
+
+	return newBibTeXEntry(key, fields), nil
+}
+
+// fields parses the "name = value" pairs until the closing delimiter of the entry.
+// The values are kept raw (including the braces) to be processed based on the field.
+func (p *bibtexParser) fields(closing rune) (map[string]string, error) {
+	fields := map[string]string{}
+
+	for {
+		p.skipSpaces()
+
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("unexpected end of file")
+		}
+
+		if p.input[p.pos] == closing {
+			p.pos++
+
+			return fields, nil
+		}
+
+		name := strings.ToLower(p.identifier())
+		if name == "" {
+			return nil, p.errorf("expected a field name but found %q", p.input[p.pos])
+		}
+
+		p.skipSpaces()
+
+		if p.pos >= len(p.input) || p.input[p.pos] != '=' {
+			return nil, p.errorf("expected = after the field %s", name)
+		}
+
+		p.pos++
+
+		value, err := p.value()
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+
+		fields[name] = value
+
+		p.skipSpaces()
+
+		if p.pos < len(p.input) && p.input[p.pos] == ',' {
+			p.pos++
+		}
+	}
+}
+
+// value parses a field value composed of braced or quoted texts, numbers, and
+// macros concatenated with #.
+func (p *bibtexParser) value() (string, error) {
+	var builder strings.Builder
+
+	for {
+		p.skipSpaces()
+
+		if p.pos >= len(p.input) {
+			return "", p.errorf("unexpected end of file")
+		}
+
+		switch char := p.input[p.pos]; {
+		case char == '{':
+			part, err := p.delimited('}')
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteString(part)
+		case char == '"':
+			part, err := p.delimited('"')
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteString(part)
+		case unicode.IsDigit(char):
+			start := p.pos
+
+			for p.pos < len(p.input) && unicode.IsDigit(p.input[p.pos]) {
+				p.pos++
+			}
+
+			builder.WriteString(string(p.input[start:p.pos]))
+		default:
+			name := p.identifier()
+			if name == "" {
+				return "", p.errorf("unexpected %q", char)
+			}
+
+			macro, ok := p.macros[strings.ToLower(name)]
+			if !ok {
+				return "", p.errorf("undefined string %s", name)
+			}
+
+			builder.WriteString(macro)
+		}
+
+		p.skipSpaces()
+
+		if p.pos >= len(p.input) || p.input[p.pos] != '#' {
+			return builder.String(), nil
+		}
+
+		p.pos++
+	}
+}
+
+// delimited returns the text between the current delimiter and the closing one,
+// keeping the nested braces.
+func (p *bibtexParser) delimited(closing rune) (string, error) {
+	p.pos++
+
+	start := p.pos
+	depth := 0
+
+	for ; p.pos < len(p.input); p.pos++ {
+		switch char := p.input[p.pos]; {
+		case char == '\\':
+			p.pos++
+		case char == closing && depth == 0:
+			text := string(p.input[start:p.pos])
+			p.pos++
+
+			return text, nil
+		case char == '{':
+			depth++
+		case char == '}':
+			depth--
+		}
+	}
+
+	return "", p.errorf("unterminated value")
+}
+
+func (p *bibtexParser) identifier() string {
+	start := p.pos
+
+	for p.pos < len(p.input) {
+		char := p.input[p.pos]
+		if !unicode.IsLetter(char) && !unicode.IsDigit(char) && !strings.ContainsRune("_-:.+/'", char) {
+			break
+		}
+
+		p.pos++
+	}
+
+	return string(p.input[start:p.pos])
+}
+
+func (p *bibtexParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *bibtexParser) errorf(format string, args ...any) error {
+	line := 1 + strings.Count(string(p.input[:min(p.pos, len(p.input))]), "\n")
+
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func newBibTeXEntry(key string, fields map[string]string) Entry {
+	entry := Entry{
+		Key:   key,
+		Title: cleanLaTeX(fields["title"]),
+		Publisher: cleanLaTeX(firstNonEmpty(
+			fields["journal"], fields["journaltitle"], fields["booktitle"],
+			fields["publisher"], fields["school"], fields["institution"], fields["organization"],
+		)),
+		Link: firstNonEmpty(doiLink(cleanLaTeX(fields["doi"])), cleanLaTeX(fields["url"])),
+	}
+
+	for _, author := range splitTopLevel(fields["author"], " and ") {
+		if name := bibtexName(author); name != "" {
+			entry.Authors = append(entry.Authors, name)
+		}
+	}
+
+	// BibLaTeX date field (e.g. 2021-03-15) takes precedence over the BibTeX fields.
+	if date := cleanLaTeX(fields["date"]); date != "" {
+		entry.Year, entry.Month, entry.Day = parseISODate(date)
+	} else {
+		entry.Year, _ = strconv.Atoi(cleanLaTeX(fields["year"]))
+		entry.Month = parseMonth(cleanLaTeX(fields["month"]))
+		entry.Day, _ = strconv.Atoi(cleanLaTeX(fields["day"]))
+	}
+
+	return entry
+}
+
+// bibtexName converts a BibTeX name ("Last, First", "Last, Jr, First", or "First Last")
+// to the "First Last" form.
+func bibtexName(raw string) string {
+	parts := splitTopLevel(raw, ",")
+
+	for i := range parts {
+		parts[i] = cleanLaTeX(parts[i])
+	}
+
+	switch len(parts) {
+	case 1:
+		if parts[0] == "others" {
+			return "et al."
+		}
+
+		return parts[0]
+	case 2:
+		return strings.TrimSpace(parts[1] + " " + parts[0])
+	}
+
+	return strings.TrimSpace(parts[2] + " " + parts[0] + " " + parts[1])
+}
+
+// splitTopLevel splits the raw value on the separator when it is not enclosed in braces.
+func splitTopLevel(raw string, separator string) []string {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+
+	for i := 0; i < len(raw); i++ {
+		switch {
+		case raw[i] == '{':
+			depth++
+		case raw[i] == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(raw[i:], separator):
+			parts = append(parts, strings.TrimSpace(raw[start:i]))
+			start = i + len(separator)
+			i = start - 1
+		}
+	}
+
+	if last := strings.TrimSpace(raw[start:]); last != "" || len(parts) > 0 {
+		parts = append(parts, last)
+	}
+
+	return parts
+}
+
+// latexAccents maps the accent commands followed by a letter to the accented letter.
+//
+//nolint:gochecknoglobals
+var latexAccents = map[string]string{
+	`'a`: "á", `'e`: "é", `'i`: "í", `'o`: "ó", `'u`: "ú", `'y`: "ý", `'c`: "ć", `'n`: "ń", `'s`: "ś", `'z`: "ź",
+	`'A`: "Á", `'E`: "É", `'I`: "Í", `'O`: "Ó", `'U`: "Ú", `'Y`: "Ý", `'C`: "Ć", `'N`: "Ń", `'S`: "Ś", `'Z`: "Ź",
+	"`a": "à", "`e": "è", "`i": "ì", "`o": "ò", "`u": "ù",
+	"`A": "À", "`E": "È", "`I": "Ì", "`O": "Ò", "`U": "Ù",
+	`"a`: "ä", `"e`: "ë", `"i`: "ï", `"o`: "ö", `"u`: "ü", `"y`: "ÿ",
+	`"A`: "Ä", `"E`: "Ë", `"I`: "Ï", `"O`: "Ö", `"U`: "Ü",
+	`^a`: "â", `^e`: "ê", `^i`: "î", `^o`: "ô", `^u`: "û",
+	`^A`: "Â", `^E`: "Ê", `^I`: "Î", `^O`: "Ô", `^U`: "Û",
+	`~a`: "ã", `~n`: "ñ", `~o`: "õ", `~A`: "Ã", `~N`: "Ñ", `~O`: "Õ",
+	`cc`: "ç", `cC`: "Ç", `cs`: "ş", `cS`: "Ş",
+	`vc`: "č", `ve`: "ě", `vr`: "ř", `vs`: "š", `vz`: "ž", `vC`: "Č", `vR`: "Ř", `vS`: "Š", `vZ`: "Ž",
+	`ug`: "ğ", `uG`: "Ğ", `Ho`: "ő", `Hu`: "ű", `HO`: "Ő", `HU`: "Ű",
+}
+
+// latexSymbols maps the commands without arguments to their characters.
+//
+//nolint:gochecknoglobals
+var latexSymbols = map[string]string{
+	"ss": "ß", "o": "ø", "O": "Ø", "ae": "æ", "AE": "Æ", "oe": "œ", "OE": "Œ",
+	"aa": "å", "AA": "Å", "l": "ł", "L": "Ł", "i": "ı",
+}
+
+// cleanLaTeX converts the LaTeX markup of a value to plain text, e.g. {\"o} to ö.
+func cleanLaTeX(raw string) string {
+	var builder strings.Builder
+
+	runes := []rune(raw)
+
+	for i := 0; i < len(runes); i++ {
+		switch char := runes[i]; char {
+		case '{', '}':
+		case '~':
+			builder.WriteRune(' ')
+		case '\\':
+			i = writeLaTeXCommand(&builder, runes, i+1) - 1
+		default:
+			builder.WriteRune(char)
+		}
+	}
+
+	text := strings.NewReplacer("---", "—", "--", "–").Replace(builder.String())
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// writeLaTeXCommand writes the text of the command starting at the index (after the backslash)
+// and returns the index after the command.
+func writeLaTeXCommand(builder *strings.Builder, runes []rune, index int) int {
+	if index >= len(runes) {
+		return index
+	}
+
+	command := runes[index]
+
+	if !unicode.IsLetter(command) {
+		// Accent command using a symbol (e.g. \'e) or an escaped character (e.g. \&).
+		if strings.ContainsRune("'`\"^~=.", command) {
+			letter, next := accentArgument(runes, index+1)
+			writeAccent(builder, string(command), letter)
+
+			return next
+		}
+
+		builder.WriteRune(command)
+
+		return index + 1
+	}
+
+	end := index
+	for end < len(runes) && unicode.IsLetter(runes[end]) {
+		end++
+	}
+
+	name := string(runes[index:end])
+
+	if symbol, ok := latexSymbols[name]; ok {
+		return skipCommandSpace(runes, end, builder, symbol)
+	}
+
+	if len(name) == 1 && strings.ContainsRune("cvuH", command) {
+		letter, next := accentArgument(runes, end)
+		writeAccent(builder, name, letter)
+
+		return next
+	}
+
+	// Unknown commands (e.g. \emph or \textbf) are dropped while their arguments are kept.
+	return skipCommandSpace(runes, end, builder, "")
+}
+
+func skipCommandSpace(runes []rune, index int, builder *strings.Builder, text string) int {
+	builder.WriteString(text)
+
+	for index < len(runes) && runes[index] == ' ' {
+		index++
+	}
+
+	return index
+}
+
+// accentArgument returns the letter an accent is applied to (e.g. e in \'e, \'{e}, or \c c)
+// and the index after it.
+func accentArgument(runes []rune, index int) (string, int) {
+	for index < len(runes) && runes[index] == ' ' {
+		index++
+	}
+
+	if index >= len(runes) {
+		return "", index
+	}
+
+	if runes[index] != '{' {
+		return string(runes[index]), index + 1
+	}
+
+	end := index + 1
+	for end < len(runes) && runes[end] != '}' {
+		end++
+	}
+
+	letter := strings.TrimPrefix(string(runes[index+1:min(end, len(runes))]), `\`)
+
+	return letter, min(end+1, len(runes))
+}
+
+func writeAccent(builder *strings.Builder, accent string, letter string) {
+	if accented, ok := latexAccents[accent+letter]; ok {
+		builder.WriteString(accented)
+
+		return
+	}
+
+	builder.WriteString(letter)
+}
+
+// parseMonth parses a month number or its (abbreviated) English name.
+func parseMonth(value string) int {
+	if month, err := strconv.Atoi(value); err == nil && month >= 1 && month <= 12 {
+		return month
+	}
+
+	value = strings.ToLower(value)
+	if len(value) < 3 {
+		return 0
+	}
+
+	for index, name := range []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"} {
+		if strings.HasPrefix(value, name) {
+			return index + 1
+		}
+	}
+
+	return 0
+}
+
+// parseISODate parses the year, month, and day of a (partial) ISO 8601 date such as 2021-03.
+func parseISODate(value string) (int, int, int) {
+	// Date ranges (e.g. 2020-01/2020-03) are represented by their start.
+	value, _, _ = strings.Cut(value, "/")
+
+	var date [3]int
+
+	for i, part := range strings.SplitN(value, "-", len(date)) {
+		date[i], _ = strconv.Atoi(strings.TrimSpace(part))
+	}
+
+	return date[0], date[1], date[2]
+}
@@ -0,0 +1,100 @@
+package bibliography
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type cslItem struct {
+	ID             json.RawMessage `json:"id"`
+	Title          string          `json:"title"`
+	Author         []cslName       `json:"author"`
+	ContainerTitle string          `json:"container-title"`
+	Publisher      string          `json:"publisher"`
+	Issued         *cslDate        `json:"issued"`
+	DOI            string          `json:"DOI"`
+	URL            string          `json:"URL"`
+}
+
+type cslName struct {
+	Family              string `json:"family"`
+	Given               string `json:"given"`
+	NonDroppingParticle string `json:"non-dropping-particle"`
+	Suffix              string `json:"suffix"`
+	Literal             string `json:"literal"`
+}
+
+type cslDate struct {
+	DateParts [][]json.Number `json:"date-parts"`
+	Raw       string          `json:"raw"`
+}
+
+// ParseCSLJSON parses the items of a CSL-JSON file, which is the format
+// exported by most reference managers (e.g. Zotero) and used by Pandoc.
+func ParseCSLJSON(content []byte) ([]Entry, error) {
+	var items []cslItem
+
+	if err := json.Unmarshal(content, &items); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidContent, err)
+	}
+
+	entries := make([]Entry, 0, len(items))
+
+	for _, item := range items {
+		entry := Entry{
+			Key:       cslID(item.ID),
+			Title:     strings.TrimSpace(item.Title),
+			Publisher: firstNonEmpty(item.ContainerTitle, item.Publisher),
+			Link:      firstNonEmpty(doiLink(item.DOI), item.URL),
+		}
+
+		for _, author := range item.Author {
+			if name := author.String(); name != "" {
+				entry.Authors = append(entry.Authors, name)
+			}
+		}
+
+		if item.Issued != nil {
+			entry.Year, entry.Month, entry.Day = item.Issued.parts()
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// cslID returns the id of the item, which can be either a string or a number.
+func cslID(raw json.RawMessage) string {
+	var id string
+
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return id
+	}
+
+	return strings.TrimSpace(string(raw))
+}
+
+func (n cslName) String() string {
+	if n.Literal != "" {
+		return strings.TrimSpace(n.Literal)
+	}
+
+	return strings.Join(strings.Fields(strings.Join([]string{n.Given, n.NonDroppingParticle, n.Family, n.Suffix}, " ")), " ")
+}
+
+func (d cslDate) parts() (int, int, int) {
+	if len(d.DateParts) == 0 || len(d.DateParts[0]) == 0 {
+		return parseISODate(d.Raw)
+	}
+
+	var date [3]int
+
+	for i, part := range d.DateParts[0][:min(len(d.DateParts[0]), len(date))] {
+		value, _ := part.Int64()
+		date[i] = int(value)
+	}
+
+	return date[0], date[1], date[2]
+}
@@ -0,0 +1,101 @@
+package bibliography
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// Select returns the entries matching the filter in the requested order.
+// A nil filter keeps all the entries.
+func Select(entries []Entry, filter *types.SchemaPublicationsFilter, order types.PublicationSort) []Entry {
+	selected := make([]Entry, 0, len(entries))
+
+	for _, entry := range entries {
+		if matches(entry, filter) {
+			selected = append(selected, entry)
+		}
+	}
+
+	switch order {
+	case types.PublicationSortNewest:
+		slices.SortStableFunc(selected, func(a, b Entry) int { return compareDates(b, a) })
+	case types.PublicationSortOldest:
+		slices.SortStableFunc(selected, compareDates)
+	case types.PublicationSortTitle:
+		slices.SortStableFunc(selected, func(a, b Entry) int {
+			return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		})
+	case types.PublicationSortNone:
+	}
+
+	return selected
+}
+
+func matches(entry Entry, filter *types.SchemaPublicationsFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Keys) > 0 && !slices.Contains(filter.Keys, entry.Key) {
+		return false
+	}
+
+	if filter.FromYear > 0 && entry.Year < filter.FromYear {
+		return false
+	}
+
+	return filter.ToYear == 0 || entry.Year <= filter.ToYear
+}
+
+func compareDates(a, b Entry) int {
+	return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Month, b.Month), cmp.Compare(a.Day, b.Day))
+}
+
+// Entities converts the entries to the publication entities of the schema.
+// Entries missing a field that is required by the schema are skipped and reported.
+func Entities(entries []Entry) ([]types.SchemaPublicationsEntity, []string) {
+	var (
+		entities []types.SchemaPublicationsEntity
+		skipped  []string
+	)
+
+	for _, entry := range entries {
+		var missing []string
+
+		if entry.Title == "" {
+			missing = append(missing, "title")
+		}
+
+		if entry.Publisher == "" {
+			missing = append(missing, "publisher")
+		}
+
+		if entry.Year == 0 {
+			missing = append(missing, "date")
+		}
+
+		if entry.Link == "" {
+			missing = append(missing, "doi or url")
+		}
+
+		if len(missing) > 0 {
+			skipped = append(skipped, fmt.Sprintf("%s (missing %s)", entry.Key, strings.Join(missing, ", ")))
+
+			continue
+		}
+
+		entities = append(entities, types.SchemaPublicationsEntity{
+			Title:       entry.Title,
+			Publisher:   entry.Publisher,
+			PublishDate: entry.Date(),
+			Link:        entry.Link,
+			Authors:     entry.Authors,
+		})
+	}
+
+	return entities, skipped
+}
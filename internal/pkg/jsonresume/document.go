@@ -0,0 +1,195 @@
+package jsonresume
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// object is a JSON object of the resume being converted. Every value read from the object
+// is removed from it, so the remaining values are the ones that could not be mapped.
+// All methods are safe to be called on a nil object (i.e. a missing value).
+type object struct {
+	path     string
+	values   map[string]any
+	unmapped *[]string
+}
+
+func newObject(path string, values map[string]any, unmapped *[]string) *object {
+	return &object{
+		path:     path,
+		values:   values,
+		unmapped: unmapped,
+	}
+}
+
+// string pops the string value of the key. Values with an unexpected type are reported as unmapped.
+func (o *object) string(key string) string {
+	value, ok := o.pop(key)
+	if !ok || value == nil {
+		return ""
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		o.report(o.keyPath(key))
+
+		return ""
+	}
+
+	return strings.TrimSpace(text)
+}
+
+// strings pops the list of strings of the key. Items with an unexpected type are reported as unmapped.
+func (o *object) strings(key string) []string {
+	value, ok := o.pop(key)
+	if !ok || value == nil {
+		return nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		o.report(o.keyPath(key))
+
+		return nil
+	}
+
+	texts := make([]string, 0, len(items))
+
+	for i, item := range items {
+		text, ok := item.(string)
+		if !ok {
+			o.report(fmt.Sprintf("%s[%d]", o.keyPath(key), i))
+
+			continue
+		}
+
+		if text = strings.TrimSpace(text); text != "" {
+			texts = append(texts, text)
+		}
+	}
+
+	return texts
+}
+
+// object pops the nested object of the key.
+func (o *object) object(key string) *object {
+	value, ok := o.pop(key)
+	if !ok || value == nil {
+		return nil
+	}
+
+	values, ok := value.(map[string]any)
+	if !ok {
+		o.report(o.keyPath(key))
+
+		return nil
+	}
+
+	return newObject(o.keyPath(key), values, o.unmapped)
+}
+
+// objects pops the list of nested objects of the key.
+func (o *object) objects(key string) []*object {
+	value, ok := o.pop(key)
+	if !ok || value == nil {
+		return nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		o.report(o.keyPath(key))
+
+		return nil
+	}
+
+	objects := make([]*object, 0, len(items))
+
+	for i, item := range items {
+		path := fmt.Sprintf("%s[%d]", o.keyPath(key), i)
+
+		values, ok := item.(map[string]any)
+		if !ok {
+			o.report(path)
+
+			continue
+		}
+
+		objects = append(objects, newObject(path, values, o.unmapped))
+	}
+
+	return objects
+}
+
+// done reports all the values that have not been read as unmapped.
+func (o *object) done() {
+	if o == nil {
+		return
+	}
+
+	keys := make([]string, 0, len(o.values))
+
+	for key, value := range o.values {
+		if !isEmpty(value) {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		o.report(o.keyPath(key))
+	}
+
+	clear(o.values)
+}
+
+// skip reports the whole object as unmapped, e.g. when it lacks a value required by the schema.
+func (o *object) skip() {
+	o.report(o.path)
+}
+
+func (o *object) pop(key string) (any, bool) {
+	if o == nil {
+		return nil, false
+	}
+
+	value, ok := o.values[key]
+	delete(o.values, key)
+
+	return value, ok
+}
+
+func (o *object) report(path string) {
+	*o.unmapped = append(*o.unmapped, path)
+}
+
+func (o *object) keyPath(key string) string {
+	if o.path == "" {
+		return key
+	}
+
+	return o.path + "." + key
+}
+
+// isEmpty reports whether the value has no content, so it can be dropped without being reported.
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []any:
+		return !slices.ContainsFunc(v, func(item any) bool { return !isEmpty(item) })
+	case map[string]any:
+		for _, item := range v {
+			if !isEmpty(item) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,129 @@
+package jsonresume
+
+import (
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// FromSchema creates a JSON Resume document from the civic's schema.
+// Dates that cannot be converted to ISO 8601 dates (e.g. present) are omitted,
+// as JSON Resume considers a missing end date as an ongoing activity.
+func FromSchema(schema *types.Schema) *Resume {
+	resume := &Resume{
+		Schema: SchemaURL,
+		Basics: Basics{
+			Name:    schema.Bio.Name,
+			Label:   schema.Bio.Title,
+			Image:   schema.Bio.ProfilePicture,
+			Summary: schema.Bio.About,
+		},
+	}
+
+	if contact := schema.Bio.Contact; contact != nil {
+		resume.Basics.Email = contact.Email
+		resume.Basics.Phone = contact.Phone
+		resume.Basics.URL = contact.Website
+
+		if contact.Location != "" {
+			resume.Basics.Location = &Location{Address: contact.Location}
+		}
+
+		for _, social := range contact.ParsedSocials() {
+			profile := Profile{URL: social.Link}
+
+			if social.Name != types.SocialMediaPlatformOther {
+				profile.Network = social.Name.String()
+				profile.Username = social.DetectedUsername
+			}
+
+			resume.Basics.Profiles = append(resume.Basics.Profiles, profile)
+		}
+	}
+
+	if schema.WorkExperiences != nil {
+		for _, entity := range schema.WorkExperiences.Entities {
+			resume.Work = append(resume.Work, Work{
+				Name:       entity.Company,
+				Position:   entity.Title,
+				Location:   entity.Location,
+				StartDate:  entity.StartDate.ISO(),
+				EndDate:    entity.EndDate.ISO(),
+				Highlights: detailTexts(entity.Details),
+			})
+		}
+	}
+
+	if schema.Educations != nil {
+		for _, entity := range schema.Educations.Entities {
+			resume.Education = append(resume.Education, Education{
+				Institution: entity.University,
+				Area:        entity.Field,
+				StudyType:   entity.Degree,
+				StartDate:   entity.StartDate.ISO(),
+				EndDate:     entity.EndDate.ISO(),
+				Courses:     detailTexts(entity.Details),
+			})
+		}
+	}
+
+	if schema.Certificates != nil {
+		for _, entity := range schema.Certificates.Entities {
+			resume.Certificates = append(resume.Certificates, Certificate{
+				Name:   entity.Title,
+				Date:   entity.IssueDate.ISO(),
+				Issuer: entity.Issuer,
+			})
+		}
+	}
+
+	if schema.Publications != nil {
+		for _, entity := range schema.Publications.Entities {
+			resume.Publications = append(resume.Publications, Publication{
+				Name:        entity.Title,
+				Publisher:   entity.Publisher,
+				ReleaseDate: entity.PublishDate.ISO(),
+				URL:         entity.Link,
+				Summary:     strings.Join(detailTexts(entity.Details), "\n"),
+			})
+		}
+	}
+
+	if schema.Skills != nil {
+		for _, entity := range schema.Skills.Entities {
+			skill := Skill{Name: entity.Category}
+
+			for _, item := range entity.Items {
+				skill.Keywords = append(skill.Keywords, item.Name)
+			}
+
+			resume.Skills = append(resume.Skills, skill)
+		}
+	}
+
+	if schema.Projects != nil {
+		for _, entity := range schema.Projects.Entities {
+			resume.Projects = append(resume.Projects, Project{
+				Name:       entity.Title,
+				URL:        entity.Link,
+				Highlights: detailTexts(entity.Details),
+			})
+		}
+	}
+
+	return resume
+}
+
+func detailTexts(details []types.SchemaDetail) []string {
+	if len(details) == 0 {
+		return nil
+	}
+
+	texts := make([]string, 0, len(details))
+
+	for _, detail := range details {
+		texts = append(texts, detail.Text)
+	}
+
+	return texts
+}
@@ -0,0 +1,135 @@
+package jsonresume_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/jsonresume"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Bio: types.SchemaBio{
+			Name:  "John Doe",
+			Title: "Programmer",
+			About: "A summary of John Doe",
+			Contact: &types.SchemaBioContact{
+				Location: "San Francisco, US",
+				Email:    "john@gmail.com",
+				Socials:  []string{"https://github.com/john", "https://example.com/john"},
+			},
+		},
+		WorkExperiences: &types.SchemaWorkExperiences{
+			Entities: []types.SchemaWorkExperienceEntity{
+				{
+					Title:     "President",
+					Company:   "Company",
+					StartDate: "Jan 2013",
+					EndDate:   "present",
+					Details:   types.NewDetails("Started the company"),
+				},
+				{Title: "Intern", Company: "Other", StartDate: "05/2012", EndDate: "last summer"},
+			},
+		},
+		Educations: &types.SchemaEducations{
+			Entities: []types.SchemaEducationsEntity{
+				{
+					Degree:     "Bachelor",
+					Field:      "Software Development",
+					University: "University",
+					StartDate:  "2011",
+					EndDate:    "2013-06-30",
+					Details:    types.NewDetails("DB1101 - Basic SQL"),
+				},
+			},
+		},
+		Certificates: &types.SchemaCertificates{
+			Entities: []types.SchemaCertificatesEntity{
+				{Title: "Certificate", Issuer: "Company", IssueDate: "2021/11/07"},
+			},
+		},
+		Publications: &types.SchemaPublications{
+			Entities: []types.SchemaPublicationsEntity{
+				{
+					Title:       "Publication",
+					Publisher:   "Company",
+					PublishDate: "2014-10",
+					Link:        "https://publication.com",
+					Details:     types.NewDetails("First line", "Second line"),
+				},
+			},
+		},
+		Skills: &types.SchemaSkills{
+			Entities: []types.SchemaSkillsEntity{
+				{Category: "Web", Items: []types.SchemaSkillsEntityItem{{Name: "HTML", Level: 5}, {Name: "CSS"}}},
+			},
+		},
+		Projects: &types.SchemaProjects{
+			Entities: []types.SchemaProjectsEntity{
+				{Title: "Project", Link: "https://project.com", Details: types.NewDetails("Won an award")},
+			},
+		},
+	}
+
+	expected := &jsonresume.Resume{
+		Schema: jsonresume.SchemaURL,
+		Basics: jsonresume.Basics{
+			Name:     "John Doe",
+			Label:    "Programmer",
+			Email:    "john@gmail.com",
+			Summary:  "A summary of John Doe",
+			Location: &jsonresume.Location{Address: "San Francisco, US"},
+			Profiles: []jsonresume.Profile{
+				{Network: "github", Username: "john", URL: "https://github.com/john"},
+				{URL: "https://example.com/john"},
+			},
+		},
+		Work: []jsonresume.Work{
+			{Name: "Company", Position: "President", StartDate: "2013-01", Highlights: []string{"Started the company"}},
+			{Name: "Other", Position: "Intern", StartDate: "2012-05"},
+		},
+		Education: []jsonresume.Education{
+			{
+				Institution: "University",
+				Area:        "Software Development",
+				StudyType:   "Bachelor",
+				StartDate:   "2011",
+				EndDate:     "2013-06-30",
+				Courses:     []string{"DB1101 - Basic SQL"},
+			},
+		},
+		Certificates: []jsonresume.Certificate{{Name: "Certificate", Date: "2021-11-07", Issuer: "Company"}},
+		Publications: []jsonresume.Publication{
+			{
+				Name:        "Publication",
+				Publisher:   "Company",
+				ReleaseDate: "2014-10",
+				URL:         "https://publication.com",
+				Summary:     "First line\nSecond line",
+			},
+		},
+		Skills:   []jsonresume.Skill{{Name: "Web", Keywords: []string{"HTML", "CSS"}}},
+		Projects: []jsonresume.Project{{Name: "Project", URL: "https://project.com", Highlights: []string{"Won an award"}}},
+	}
+
+	require.Equal(t, expected, jsonresume.FromSchema(schema))
+}
+
+func TestFromSchema_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	schema, _, err := jsonresume.Convert(sampleResume)
+
+	require.NoError(t, err)
+
+	resume := jsonresume.FromSchema(schema)
+
+	require.Equal(t, "John Doe", resume.Basics.Name)
+	require.Equal(t, "2013-01-01", resume.Work[0].StartDate)
+	require.Equal(t, "2014-01-01", resume.Work[0].EndDate)
+	require.Equal(t, []string{"HTML", "CSS", "JavaScript"}, resume.Skills[0].Keywords)
+	require.Equal(t, "https://publication.com", resume.Publications[0].URL)
+}
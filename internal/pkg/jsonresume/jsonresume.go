@@ -0,0 +1,286 @@
+// Package jsonresume converts resumes in the JSON Resume format (https://jsonresume.org/schema)
+// to the civic's schema.
+package jsonresume
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+var ErrInvalidResume = errors.New("content is not a valid JSON Resume document")
+
+// Convert maps the JSON Resume document onto the civic's schema. Along with the schema,
+// it returns the path (e.g. work[0].highlights[1]) of every field that could not be mapped,
+// including the entries (e.g. projects[1]) that are skipped because they lack a required value.
+// The returned schema uses the default template and is not validated.
+func Convert(content []byte) (*types.Schema, []string, error) {
+	document := make(map[string]any)
+
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, nil, errors.Join(ErrInvalidResume, err)
+	}
+
+	var unmapped []string
+
+	resume := newObject("", document, &unmapped)
+
+	// $schema only points to the JSON schema of the document and has no content to be mapped.
+	resume.pop("$schema")
+
+	schema := &types.Schema{
+		Template: types.SchemaTemplate{Name: types.DefaultTemplateName},
+		Bio:      convertBasics(resume.object("basics")),
+	}
+
+	schema.WorkExperiences = convertWork(resume.objects("work"))
+	schema.Educations = convertEducation(resume.objects("education"))
+	schema.Certificates = convertCertificates(resume.objects("certificates"))
+	schema.Publications = convertPublications(resume.objects("publications"))
+	schema.Skills = convertSkills(resume.objects("skills"))
+	schema.Projects = convertProjects(resume.objects("projects"))
+
+	resume.done()
+
+	return schema, unmapped, nil
+}
+
+func convertBasics(basics *object) types.SchemaBio {
+	bio := types.SchemaBio{
+		Name:           basics.string("name"),
+		Title:          basics.string("label"),
+		ProfilePicture: basics.string("image"),
+		About:          basics.string("summary"),
+	}
+
+	contact := types.SchemaBioContact{
+		Email:    basics.string("email"),
+		Phone:    basics.string("phone"),
+		Website:  basics.string("url"),
+		Location: convertLocation(basics.object("location")),
+	}
+
+	for _, profile := range basics.objects("profiles") {
+		// network and username are already part of the link, and the link is the only
+		// information kept by the schema.
+		if link := profile.string("url"); link != "" {
+			contact.Socials = append(contact.Socials, link)
+
+			profile.pop("network")
+			profile.pop("username")
+		}
+
+		profile.done()
+	}
+
+	if contact.Email != "" || contact.Phone != "" || contact.Website != "" || contact.Location != "" ||
+		len(contact.Socials) > 0 {
+		bio.Contact = &contact
+	}
+
+	basics.done()
+
+	return bio
+}
+
+func convertLocation(location *object) string {
+	parts := []string{
+		location.string("address"),
+		strings.TrimSpace(location.string("postalCode") + " " + location.string("city")),
+		location.string("region"),
+		location.string("countryCode"),
+	}
+
+	location.done()
+
+	return joinNonEmpty(parts, ", ")
+}
+
+func convertWork(items []*object) *types.SchemaWorkExperiences {
+	if len(items) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaWorkExperienceEntity, 0, len(items))
+
+	for _, item := range items {
+		entity := types.SchemaWorkExperienceEntity{
+			Title:     item.string("position"),
+			Company:   item.string("name"),
+			Location:  item.string("location"),
+			StartDate: types.Date(item.string("startDate")),
+			EndDate:   types.Date(item.string("endDate")),
+			Details:   summaryDetails(item.string("summary"), item.strings("highlights")),
+		}
+
+		item.done()
+
+		entities = append(entities, entity)
+	}
+
+	return &types.SchemaWorkExperiences{Entities: entities}
+}
+
+func convertEducation(items []*object) *types.SchemaEducations {
+	if len(items) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaEducationsEntity, 0, len(items))
+
+	for _, item := range items {
+		entity := types.SchemaEducationsEntity{
+			Degree:     item.string("studyType"),
+			Field:      item.string("area"),
+			University: item.string("institution"),
+			StartDate:  types.Date(item.string("startDate")),
+			EndDate:    types.Date(item.string("endDate")),
+			Details:    types.NewDetails(item.strings("courses")...),
+		}
+
+		item.done()
+
+		entities = append(entities, entity)
+	}
+
+	return &types.SchemaEducations{Entities: entities}
+}
+
+func convertCertificates(items []*object) *types.SchemaCertificates {
+	if len(items) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaCertificatesEntity, 0, len(items))
+
+	for _, item := range items {
+		entity := types.SchemaCertificatesEntity{
+			Title:     item.string("name"),
+			Issuer:    item.string("issuer"),
+			IssueDate: types.Date(item.string("date")),
+		}
+
+		item.done()
+
+		entities = append(entities, entity)
+	}
+
+	return &types.SchemaCertificates{Entities: entities}
+}
+
+func convertPublications(items []*object) *types.SchemaPublications {
+	if len(items) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaPublicationsEntity, 0, len(items))
+
+	for _, item := range items {
+		entity := types.SchemaPublicationsEntity{
+			Title:       item.string("name"),
+			Publisher:   item.string("publisher"),
+			PublishDate: types.Date(item.string("releaseDate")),
+			Link:        item.string("url"),
+			Details:     summaryDetails(item.string("summary"), nil),
+		}
+
+		item.done()
+
+		// JSON Resume does not require the publisher, date, or url of a publication, while the schema does.
+		if types.IsValidEntity(entity) != nil {
+			item.skip()
+
+			continue
+		}
+
+		entities = append(entities, entity)
+	}
+
+	if len(entities) == 0 {
+		return nil
+	}
+
+	return &types.SchemaPublications{Entities: entities}
+}
+
+func convertSkills(items []*object) *types.SchemaSkills {
+	if len(items) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaSkillsEntity, 0, len(items))
+
+	for _, item := range items {
+		entity := types.SchemaSkillsEntity{
+			Category: item.string("name"),
+		}
+
+		for _, keyword := range item.strings("keywords") {
+			entity.Items = append(entity.Items, types.SchemaSkillsEntityItem{Name: keyword})
+		}
+
+		// The level of JSON Resume skills is a free text (e.g. Master) of the whole category,
+		// while the schema expects a gauge value for each item, so it is reported as unmapped.
+		item.done()
+
+		entities = append(entities, entity)
+	}
+
+	return &types.SchemaSkills{Entities: entities}
+}
+
+func convertProjects(items []*object) *types.SchemaProjects {
+	if len(items) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaProjectsEntity, 0, len(items))
+
+	for _, item := range items {
+		entity := types.SchemaProjectsEntity{
+			Title:   item.string("name"),
+			Link:    item.string("url"),
+			Details: summaryDetails(item.string("description"), item.strings("highlights")),
+		}
+
+		item.done()
+
+		// JSON Resume does not require the url of a project, while the schema does.
+		if types.IsValidEntity(entity) != nil {
+			item.skip()
+
+			continue
+		}
+
+		entities = append(entities, entity)
+	}
+
+	if len(entities) == 0 {
+		return nil
+	}
+
+	return &types.SchemaProjects{Entities: entities}
+}
+
+// summaryDetails returns the summary (if any) followed by the highlights as detail lines.
+func summaryDetails(summary string, highlights []string) []types.SchemaDetail {
+	if summary == "" {
+		return types.NewDetails(highlights...)
+	}
+
+	return types.NewDetails(append([]string{summary}, highlights...)...)
+}
+
+func joinNonEmpty(parts []string, sep string) string {
+	nonEmpty := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, sep)
+}
@@ -0,0 +1,192 @@
+package jsonresume_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/jsonresume"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+
+	_ "embed"
+)
+
+//go:embed testdata/resume.json
+var sampleResume []byte
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	schema, unmapped, err := jsonresume.Convert(sampleResume)
+
+	require.NoError(t, err)
+	require.NoError(t, schema.IsValid())
+
+	require.Equal(
+		t, []string{
+			"basics.profiles[1].network",
+			"basics.profiles[1].username",
+			"work[0].url",
+			"education[0].score",
+			"education[0].url",
+			"certificates[0].url",
+			"skills[0].level",
+			"projects[0].startDate",
+			"volunteer",
+		},
+		unmapped,
+	)
+
+	require.Equal(t, types.DefaultTemplateName, schema.Template.Name)
+
+	require.Equal(t, "John Doe", schema.Bio.Name)
+	require.Equal(t, "Programmer", schema.Bio.Title)
+	require.Equal(t, "A summary of John Doe", schema.Bio.About)
+	require.Equal(
+		t, &types.SchemaBioContact{
+			Location: "2712 Broadway St, CA 94115 San Francisco, California, US",
+			Website:  "https://johndoe.com",
+			Email:    "john@gmail.com",
+			Phone:    "(912) 555-4321",
+			Socials:  []string{"https://github.com/john"},
+		},
+		schema.Bio.Contact,
+	)
+
+	require.Equal(
+		t, []types.SchemaWorkExperienceEntity{
+			{
+				Title:     "President",
+				Company:   "Company",
+				StartDate: "2013-01-01",
+				EndDate:   "2014-01-01",
+				Details:   types.NewDetails("Description of the role", "Started the company"),
+			},
+		},
+		schema.WorkExperiences.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaEducationsEntity{
+			{
+				Degree:     "Bachelor",
+				Field:      "Software Development",
+				University: "University",
+				StartDate:  "2011-01-01",
+				EndDate:    "2013-01-01",
+				Details:    types.NewDetails("DB1101 - Basic SQL"),
+			},
+		},
+		schema.Educations.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaCertificatesEntity{{Title: "Certificate", Issuer: "Company", IssueDate: "2021-11-07"}},
+		schema.Certificates.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaPublicationsEntity{
+			{
+				Title:       "Publication",
+				Publisher:   "Company",
+				PublishDate: "2014-10-01",
+				Link:        "https://publication.com",
+				Details:     types.NewDetails("Description of the publication"),
+			},
+		},
+		schema.Publications.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaSkillsEntity{
+			{
+				Category: "Web Development",
+				Items:    []types.SchemaSkillsEntityItem{{Name: "HTML"}, {Name: "CSS"}, {Name: "JavaScript"}},
+			},
+		},
+		schema.Skills.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaProjectsEntity{
+			{
+				Title:   "Project",
+				Link:    "https://project.com/",
+				Details: types.NewDetails("Description of the project", "Won award at AIHacks 2016"),
+			},
+		},
+		schema.Projects.Entities,
+	)
+}
+
+func TestConvert_Partial(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		content  string
+		unmapped []string
+		hasError bool
+	}{
+		{
+			name:     "invalid json",
+			content:  `{"basics": `,
+			hasError: true,
+		},
+		{
+			name:    "only basics",
+			content: `{"basics": {"name": "John Doe", "label": "Programmer", "email": "john@gmail.com"}}`,
+		},
+		{
+			name:     "unexpected types",
+			content:  `{"basics": {"name": 1, "profiles": {"url": "x"}}, "work": [1, {"highlights": ["a", 2]}]}`,
+			unmapped: []string{"basics.name", "basics.profiles", "work[0]", "work[1].highlights[1]"},
+		},
+		{
+			name:     "empty values are not reported",
+			content:  `{"basics": {"name": "John Doe", "location": {}}, "awards": [], "meta": {"version": ""}}`,
+			unmapped: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				schema, unmapped, err := jsonresume.Convert([]byte(tc.content))
+
+				if tc.hasError {
+					require.ErrorIs(t, err, jsonresume.ErrInvalidResume)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.NotNil(t, schema)
+				require.Equal(t, tc.unmapped, unmapped)
+			},
+		)
+	}
+}
+
+func TestConvert_SkippedEntries(t *testing.T) {
+	t.Parallel()
+
+	content := `{
+		"basics": {"name": "John Doe", "label": "Programmer"},
+		"projects": [
+			{"name": "Side Project", "description": "Weekend hacking"},
+			{"name": "Civic", "url": "https://civic.dev"}
+		],
+		"publications": [{"name": "Go Tips", "releaseDate": "2021-03", "url": "https://blog.dev/go"}]
+	}`
+
+	schema, unmapped, err := jsonresume.Convert([]byte(content))
+
+	require.NoError(t, err)
+	require.NoError(t, schema.IsValid())
+	require.Equal(t, []string{"publications[0]", "projects[0]"}, unmapped)
+	require.Equal(t, []types.SchemaProjectsEntity{{Title: "Civic", Link: "https://civic.dev"}}, schema.Projects.Entities)
+	require.Nil(t, schema.Publications)
+}
@@ -0,0 +1,359 @@
+// Package linkedin converts the data export archive of LinkedIn ("Get a copy of your data")
+// to the civic's schema.
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+const (
+	fileProfile        = "Profile.csv"
+	fileEmailAddresses = "Email Addresses.csv"
+	filePositions      = "Positions.csv"
+	fileEducation      = "Education.csv"
+	fileSkills         = "Skills.csv"
+	fileCertifications = "Certifications.csv"
+	fileProjects       = "Projects.csv"
+	filePublications   = "Publications.csv"
+)
+
+var (
+	ErrInvalidArchive = errors.New("content is not a valid LinkedIn data export archive")
+
+	reLink = regexp.MustCompile(`https?://[^\s,\]]+`)
+)
+
+// Convert maps the CSV files of the LinkedIn data export archive onto the civic's schema.
+// Along with the schema, it returns the columns (e.g. "Education.csv: Activities") having
+// values that could not be mapped, and the rows (e.g. "Projects.csv: row 1 (Civic)") that are
+// skipped because they lack a required value. The returned schema uses the default template and
+// is not validated.
+func Convert(content []byte) (*types.Schema, []string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, nil, errors.Join(ErrInvalidArchive, err)
+	}
+
+	tables, err := readTables(archive)
+	if err != nil {
+		return nil, nil, errors.Join(ErrInvalidArchive, err)
+	}
+
+	if tables[fileProfile] == nil {
+		return nil, nil, fmt.Errorf("%w: %s is missing", ErrInvalidArchive, fileProfile)
+	}
+
+	schema := &types.Schema{
+		Template:        types.SchemaTemplate{Name: types.DefaultTemplateName},
+		Bio:             convertProfile(tables[fileProfile], tables[fileEmailAddresses]),
+		WorkExperiences: convertPositions(tables[filePositions]),
+		Educations:      convertEducation(tables[fileEducation]),
+		Certificates:    convertCertifications(tables[fileCertifications]),
+		Publications:    convertPublications(tables[filePublications]),
+		Skills:          convertSkills(tables[fileSkills]),
+		Projects:        convertProjects(tables[fileProjects]),
+	}
+
+	var unmapped []string
+
+	for _, name := range []string{
+		fileProfile, fileEmailAddresses, filePositions, fileEducation,
+		fileSkills, fileCertifications, fileProjects, filePublications,
+	} {
+		if tables[name] != nil {
+			unmapped = append(unmapped, tables[name].unmapped()...)
+		}
+	}
+
+	return schema, unmapped, nil
+}
+
+// readTables reads the supported CSV files of the archive regardless of their directory.
+func readTables(archive *zip.Reader) (map[string]*table, error) {
+	tables := make(map[string]*table)
+
+	for _, file := range archive.File {
+		name := supportedFileName(path.Base(file.Name))
+		if name == "" {
+			continue
+		}
+
+		content, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if tables[name], err = readTable(name, content); err != nil {
+			return nil, err
+		}
+	}
+
+	return tables, nil
+}
+
+func supportedFileName(name string) string {
+	for _, supported := range []string{
+		fileProfile, fileEmailAddresses, filePositions, fileEducation,
+		fileSkills, fileCertifications, fileProjects, filePublications,
+	} {
+		if strings.EqualFold(name, supported) {
+			return supported
+		}
+	}
+
+	return ""
+}
+
+func readFile(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file.Name, err)
+	}
+
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	return io.ReadAll(reader)
+}
+
+func convertProfile(profile *table, emails *table) types.SchemaBio {
+	var bio types.SchemaBio
+
+	// The profile file has a single row describing the member.
+	if len(profile.rows) == 0 {
+		return bio
+	}
+
+	row := profile.rows[0]
+
+	bio.Name = strings.TrimSpace(profile.value(row, "First Name") + " " + profile.value(row, "Last Name"))
+	bio.Title = profile.value(row, "Headline")
+	bio.About = profile.value(row, "Summary")
+
+	contact := types.SchemaBioContact{
+		Location: profile.value(row, "Geo Location"),
+		Email:    primaryEmail(emails),
+	}
+
+	// Websites are exported as "[TYPE:link],[TYPE:link]" and twitter handles as "[handle],[handle]".
+	contact.Socials = append(contact.Socials, reLink.FindAllString(profile.value(row, "Websites"), -1)...)
+
+	for _, handle := range strings.Split(profile.value(row, "Twitter Handles"), ",") {
+		if handle = strings.Trim(strings.TrimSpace(handle), "[]@"); handle != "" {
+			contact.Socials = append(contact.Socials, "https://x.com/"+handle)
+		}
+	}
+
+	if contact.Email != "" || contact.Location != "" || len(contact.Socials) > 0 {
+		bio.Contact = &contact
+	}
+
+	return bio
+}
+
+// primaryEmail returns the primary email address, or the first one if none is marked as primary.
+func primaryEmail(emails *table) string {
+	if emails == nil {
+		return ""
+	}
+
+	// Confirmation and update dates of the addresses are metadata without any value for the CV.
+	emails.skip("Confirmed", "Updated On")
+
+	var email string
+
+	for _, row := range emails.rows {
+		address := emails.value(row, "Email Address")
+
+		if strings.EqualFold(emails.value(row, "Primary"), "yes") {
+			return address
+		}
+
+		if email == "" {
+			email = address
+		}
+	}
+
+	return email
+}
+
+func convertPositions(positions *table) *types.SchemaWorkExperiences {
+	if positions == nil || len(positions.rows) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaWorkExperienceEntity, 0, len(positions.rows))
+
+	for _, row := range positions.rows {
+		entities = append(entities, types.SchemaWorkExperienceEntity{
+			Title:     positions.value(row, "Title"),
+			Company:   positions.value(row, "Company Name"),
+			Location:  positions.value(row, "Location"),
+			StartDate: types.Date(positions.value(row, "Started On")),
+			EndDate:   types.Date(positions.value(row, "Finished On")),
+			Details:   descriptionDetails(positions.value(row, "Description")),
+		})
+	}
+
+	return &types.SchemaWorkExperiences{Entities: entities}
+}
+
+func convertEducation(education *table) *types.SchemaEducations {
+	if education == nil || len(education.rows) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaEducationsEntity, 0, len(education.rows))
+
+	for _, row := range education.rows {
+		degree, field := splitDegree(education.value(row, "Degree Name"))
+
+		entities = append(entities, types.SchemaEducationsEntity{
+			Degree:     degree,
+			Field:      field,
+			University: education.value(row, "School Name"),
+			StartDate:  types.Date(education.value(row, "Start Date")),
+			EndDate:    types.Date(education.value(row, "End Date")),
+			Details:    descriptionDetails(education.value(row, "Notes")),
+		})
+	}
+
+	return &types.SchemaEducations{Entities: entities}
+}
+
+func convertCertifications(certifications *table) *types.SchemaCertificates {
+	if certifications == nil || len(certifications.rows) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaCertificatesEntity, 0, len(certifications.rows))
+
+	for _, row := range certifications.rows {
+		entities = append(entities, types.SchemaCertificatesEntity{
+			Title:          certifications.value(row, "Name"),
+			Issuer:         certifications.value(row, "Authority"),
+			IssueDate:      types.Date(certifications.value(row, "Started On")),
+			ExpirationDate: types.Date(certifications.value(row, "Finished On")),
+		})
+	}
+
+	return &types.SchemaCertificates{Entities: entities}
+}
+
+func convertPublications(publications *table) *types.SchemaPublications {
+	if publications == nil || len(publications.rows) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaPublicationsEntity, 0, len(publications.rows))
+
+	for index, row := range publications.rows {
+		entity := types.SchemaPublicationsEntity{
+			Title:       publications.value(row, "Name"),
+			Publisher:   publications.value(row, "Publisher"),
+			PublishDate: types.Date(publications.value(row, "Published On")),
+			Link:        publications.value(row, "Url"),
+			Details:     descriptionDetails(publications.value(row, "Description")),
+		}
+
+		// LinkedIn does not require the publisher, date, or link of a publication, while the schema does.
+		if types.IsValidEntity(entity) != nil {
+			publications.reject(index, entity.Title)
+
+			continue
+		}
+
+		entities = append(entities, entity)
+	}
+
+	if len(entities) == 0 {
+		return nil
+	}
+
+	return &types.SchemaPublications{Entities: entities}
+}
+
+func convertSkills(skills *table) *types.SchemaSkills {
+	if skills == nil || len(skills.rows) == 0 {
+		return nil
+	}
+
+	// LinkedIn does not categorize the skills, so all of them are added to a single category.
+	entity := types.SchemaSkillsEntity{Category: "Skills"}
+
+	for _, row := range skills.rows {
+		if name := skills.value(row, "Name"); name != "" {
+			entity.Items = append(entity.Items, types.SchemaSkillsEntityItem{Name: name})
+		}
+	}
+
+	return &types.SchemaSkills{Entities: []types.SchemaSkillsEntity{entity}}
+}
+
+func convertProjects(projects *table) *types.SchemaProjects {
+	if projects == nil || len(projects.rows) == 0 {
+		return nil
+	}
+
+	entities := make([]types.SchemaProjectsEntity, 0, len(projects.rows))
+
+	for index, row := range projects.rows {
+		entity := types.SchemaProjectsEntity{
+			Title:   projects.value(row, "Title"),
+			Link:    projects.value(row, "Url"),
+			Details: descriptionDetails(projects.value(row, "Description")),
+		}
+
+		// LinkedIn does not require the link of a project, while the schema does.
+		if types.IsValidEntity(entity) != nil {
+			projects.reject(index, entity.Title)
+
+			continue
+		}
+
+		entities = append(entities, entity)
+	}
+
+	if len(entities) == 0 {
+		return nil
+	}
+
+	return &types.SchemaProjects{Entities: entities}
+}
+
+// descriptionDetails splits a multi-line description into detail lines, removing the list markers.
+func descriptionDetails(description string) []types.SchemaDetail {
+	var lines []string
+
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-•*"))
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return types.NewDetails(lines...)
+}
+
+// splitDegree splits the degree name into the degree and the field of study
+// (e.g. "Bachelor of Science - BS, Computer Science"). If the degree name does not
+// include the field of study, the degree name is used for both.
+func splitDegree(degreeName string) (string, string) {
+	degree, field, found := strings.Cut(degreeName, ",")
+	if !found {
+		return degreeName, degreeName
+	}
+
+	return strings.TrimSpace(degree), strings.TrimSpace(field)
+}
@@ -0,0 +1,230 @@
+package linkedin_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/linkedin"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newArchive creates a ZIP archive with the provided files.
+func newArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer := zip.NewWriter(&buf)
+
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	archive := newArchive(
+		t, map[string]string{
+			"Profile.csv": "\ufeffFirst Name,Last Name,Maiden Name,Address,Birth Date,Headline,Summary,Industry," +
+				"Zip Code,Geo Location,Twitter Handles,Websites,Instant Messengers\n" +
+				`John,Doe,,,"Jan 1, 1990",Software Engineer,"Building things.",Software,,"Berlin, Germany",` +
+				`[johndoe],"[PORTFOLIO:https://johndoe.com],[OTHER:https://github.com/johndoe]",` + "\n",
+			"Email Addresses.csv": "Email Address,Confirmed,Primary,Updated On\n" +
+				"old@example.com,Yes,No,2020-01-01\n" +
+				"john@example.com,Yes,Yes,2021-01-01\n",
+			"Positions.csv": "Company Name,Title,Description,Location,Started On,Finished On\n" +
+				`Acme,Senior Engineer,"- Led the team` + "\n" + `- Shipped the product",Berlin,Jan 2020,` + "\n" +
+				"Globex,Engineer,,Remote,Mar 2017,Dec 2019\n",
+			"Education.csv": "School Name,Start Date,End Date,Notes,Degree Name,Activities\n" +
+				`TU Berlin,2012,2016,,"Bachelor of Science - BS, Computer Science",Chess club` + "\n",
+			"Skills.csv": "Name\nGo\nKubernetes\n",
+			"Certifications.csv": "Name,Url,Authority,Started On,Finished On,License Number\n" +
+				"CKA,,CNCF,Jan 2022,Jan 2025,\n",
+			"Projects.csv":     "Title,Description,Url,Started On,Finished On\nCivic,CV generator,https://civic.dev,,\n",
+			"Publications.csv": "Name,Published On,Description,Publisher,Url\nGo Tips,Mar 2021,,Blog,https://blog.dev/go\n",
+			"Connections.csv":  "Notes:\nnot supported\n",
+		},
+	)
+
+	schema, unmapped, err := linkedin.Convert(archive)
+
+	require.NoError(t, err)
+	require.NoError(t, schema.IsValid())
+
+	require.Equal(
+		t, []string{
+			"Profile.csv: Birth Date",
+			"Profile.csv: Industry",
+			"Education.csv: Activities",
+		},
+		unmapped,
+	)
+
+	require.Equal(t, types.DefaultTemplateName, schema.Template.Name)
+	require.Equal(t, "John Doe", schema.Bio.Name)
+	require.Equal(t, "Software Engineer", schema.Bio.Title)
+	require.Equal(t, "Building things.", schema.Bio.About)
+	require.Equal(
+		t, &types.SchemaBioContact{
+			Location: "Berlin, Germany",
+			Email:    "john@example.com",
+			Socials:  []string{"https://johndoe.com", "https://github.com/johndoe", "https://x.com/johndoe"},
+		},
+		schema.Bio.Contact,
+	)
+
+	require.Equal(
+		t, []types.SchemaWorkExperienceEntity{
+			{
+				Title:     "Senior Engineer",
+				Company:   "Acme",
+				Location:  "Berlin",
+				StartDate: "Jan 2020",
+				Details:   types.NewDetails("Led the team", "Shipped the product"),
+			},
+			{Title: "Engineer", Company: "Globex", Location: "Remote", StartDate: "Mar 2017", EndDate: "Dec 2019"},
+		},
+		schema.WorkExperiences.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaEducationsEntity{
+			{
+				Degree:     "Bachelor of Science - BS",
+				Field:      "Computer Science",
+				University: "TU Berlin",
+				StartDate:  "2012",
+				EndDate:    "2016",
+			},
+		},
+		schema.Educations.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaSkillsEntity{
+			{Category: "Skills", Items: []types.SchemaSkillsEntityItem{{Name: "Go"}, {Name: "Kubernetes"}}},
+		},
+		schema.Skills.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaCertificatesEntity{
+			{Title: "CKA", Issuer: "CNCF", IssueDate: "Jan 2022", ExpirationDate: "Jan 2025"},
+		},
+		schema.Certificates.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaProjectsEntity{
+			{Title: "Civic", Link: "https://civic.dev", Details: types.NewDetails("CV generator")},
+		},
+		schema.Projects.Entities,
+	)
+
+	require.Equal(
+		t, []types.SchemaPublicationsEntity{
+			{Title: "Go Tips", Publisher: "Blog", PublishDate: "Mar 2021", Link: "https://blog.dev/go"},
+		},
+		schema.Publications.Entities,
+	)
+}
+
+func TestConvert_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content func(t *testing.T) []byte
+	}{
+		{
+			name: "not an archive",
+			content: func(t *testing.T) []byte {
+				t.Helper()
+
+				return []byte("First Name,Last Name\n")
+			},
+		},
+		{
+			name: "missing profile",
+			content: func(t *testing.T) []byte {
+				t.Helper()
+
+				return newArchive(t, map[string]string{"Skills.csv": "Name\nGo\n"})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, _, err := linkedin.Convert(tc.content(t))
+
+				require.ErrorIs(t, err, linkedin.ErrInvalidArchive)
+			},
+		)
+	}
+}
+
+func TestConvert_NestedDirectory(t *testing.T) {
+	t.Parallel()
+
+	archive := newArchive(
+		t, map[string]string{
+			"Basic_LinkedInDataExport/profile.csv": "First Name,Last Name,Headline\nJohn,Doe,Engineer\n",
+		},
+	)
+
+	schema, unmapped, err := linkedin.Convert(archive)
+
+	require.NoError(t, err)
+	require.Empty(t, unmapped)
+	require.Equal(t, "John Doe", schema.Bio.Name)
+	require.Nil(t, schema.Bio.Contact)
+	require.Nil(t, schema.WorkExperiences)
+}
+
+func TestConvert_SkippedRows(t *testing.T) {
+	t.Parallel()
+
+	archive := newArchive(
+		t, map[string]string{
+			"Profile.csv": "First Name,Last Name,Headline\nJohn,Doe,Engineer\n",
+			"Projects.csv": "Title,Description,Url,Started On,Finished On\n" +
+				"Side Project,Weekend hacking,,,\n" +
+				"Civic,CV generator,https://civic.dev,,\n",
+			"Publications.csv": "Name,Published On,Description,Publisher,Url\nGo Tips,Mar 2021,,Blog,\n",
+		},
+	)
+
+	schema, unmapped, err := linkedin.Convert(archive)
+
+	require.NoError(t, err)
+	require.NoError(t, schema.IsValid())
+	require.Equal(
+		t, []string{
+			"Projects.csv: row 1 (Side Project)",
+			"Publications.csv: row 1 (Go Tips)",
+		},
+		unmapped,
+	)
+	require.Equal(
+		t, []types.SchemaProjectsEntity{
+			{Title: "Civic", Link: "https://civic.dev", Details: types.NewDetails("CV generator")},
+		},
+		schema.Projects.Entities,
+	)
+	require.Nil(t, schema.Publications)
+}
@@ -0,0 +1,96 @@
+package linkedin
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// table is a CSV file of the archive. It keeps track of the columns that are read,
+// so the columns having values that could not be mapped can be reported.
+type table struct {
+	name    string
+	columns []string
+	rows    [][]string
+	read    map[string]bool
+	skipped []string
+}
+
+func readTable(name string, content []byte) (*table, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return &table{name: name, read: map[string]bool{}}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	return &table{
+		name:    name,
+		columns: header,
+		rows:    rows,
+		read:    map[string]bool{},
+	}, nil
+}
+
+// value returns the trimmed value of the column in the row. Missing columns return an empty string.
+func (t *table) value(row []string, column string) string {
+	t.read[column] = true
+
+	index := slices.Index(t.columns, column)
+	if index < 0 || index >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[index])
+}
+
+// skip marks the columns as read, so they are not reported as unmapped.
+func (t *table) skip(columns ...string) {
+	for _, column := range columns {
+		t.read[column] = true
+	}
+}
+
+// reject marks the row as skipped, so it is reported along with the unmapped columns.
+func (t *table) reject(index int, label string) {
+	t.skipped = append(t.skipped, fmt.Sprintf("%s: row %d (%s)", t.name, index+1, label))
+}
+
+// unmapped returns the columns that have not been read while having a value in any row,
+// followed by the rows that are skipped.
+func (t *table) unmapped() []string {
+	var columns []string
+
+	for index, column := range t.columns {
+		if t.read[column] {
+			continue
+		}
+
+		for _, row := range t.rows {
+			if index < len(row) && strings.TrimSpace(row[index]) != "" {
+				columns = append(columns, t.name+": "+column)
+
+				break
+			}
+		}
+	}
+
+	return append(columns, t.skipped...)
+}
@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"syscall"
 )
@@ -60,6 +61,31 @@ func (l *GeneralLoader) Loader() Loader {
 	return l.loader
 }
 
+// ResolvePath resolves the reference path relative to the base path.
+// Remote and absolute references are returned as is. Relative references are resolved
+// against the directory of the local base path or the URL of the remote base path.
+func ResolvePath(base string, ref string) string {
+	if isRemotePath(ref) || filepath.IsAbs(ref) {
+		return ref
+	}
+
+	if isRemotePath(base) {
+		baseURL, err := url.Parse(base)
+		if err != nil {
+			return ref
+		}
+
+		refURL, err := url.Parse(ref)
+		if err != nil {
+			return ref
+		}
+
+		return baseURL.ResolveReference(refURL).String()
+	}
+
+	return filepath.Join(filepath.Dir(base), ref)
+}
+
 func isLocalPath(path string) bool {
 	// We make sure it isn't a directory.
 	if strings.HasSuffix(path, string(os.PathSeparator)) {
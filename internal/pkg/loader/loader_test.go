@@ -111,3 +111,55 @@ func TestNewGeneralLoader(t *testing.T) {
 		)
 	}
 }
+
+func TestResolvePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		base     string
+		ref      string
+		expected string
+	}{
+		{
+			name:     "relative local reference",
+			base:     "/path/to/cv.yaml",
+			ref:      "base.yaml",
+			expected: "/path/to/base.yaml",
+		},
+		{
+			name:     "relative local reference to parent",
+			base:     "path/to/cv.yaml",
+			ref:      "../shared/base.yaml",
+			expected: "path/shared/base.yaml",
+		},
+		{
+			name:     "absolute local reference",
+			base:     "/path/to/cv.yaml",
+			ref:      "/other/base.yaml",
+			expected: "/other/base.yaml",
+		},
+		{
+			name:     "remote reference",
+			base:     "/path/to/cv.yaml",
+			ref:      "https://example.com/base.yaml",
+			expected: "https://example.com/base.yaml",
+		},
+		{
+			name:     "relative reference to remote base",
+			base:     "https://example.com/cv/cv.yaml",
+			ref:      "../shared/base.yaml",
+			expected: "https://example.com/shared/base.yaml",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				require.Equal(t, tc.expected, loader.ResolvePath(tc.base, tc.ref))
+			},
+		)
+	}
+}
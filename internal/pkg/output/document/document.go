@@ -0,0 +1,258 @@
+// Package document builds a format-agnostic outline of the schema for the generators
+// that write the output directly from the schema (e.g. DOCX) instead of using the template.
+package document
+
+import (
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// Link is a text that optionally points to a URL.
+type Link struct {
+	Text string
+	URL  string
+}
+
+// Document is the outline of the resume or cv in the order it should be rendered.
+type Document struct {
+	Name  string
+	Title string
+	About string
+
+	// Contact contains the contact information, the socials, and the custom data of the bio.
+	Contact []Link
+
+	Sections []Section
+}
+
+// Section is a section of the document with its header and entries.
+type Section struct {
+	Header  string
+	Entries []Entry
+}
+
+// Entry is a single item of a section, e.g. a work experience or a skill category.
+// All the fields are optional.
+type Entry struct {
+	Title string
+
+	// Link is the URL the title points to.
+	Link string
+
+	// Subtitle is the organization of the entry, e.g. the company or the university.
+	Subtitle string
+
+	// Meta contains the secondary information of the entry, e.g. its dates and location.
+	Meta []string
+
+	// Summary is a single line of text, e.g. the skills of a category.
+	Summary string
+
+	Details []string
+}
+
+// New creates the outline of the schema, respecting its section order.
+func New(schema *types.Schema) *Document {
+	doc := &Document{
+		Name:    schema.Bio.Name,
+		Title:   schema.Bio.Title,
+		About:   schema.Bio.About,
+		Contact: contactLinks(schema.Bio),
+	}
+
+	for _, name := range schema.Sections() {
+		doc.Sections = append(doc.Sections, newSections(schema, name)...)
+	}
+
+	return doc
+}
+
+func contactLinks(bio types.SchemaBio) []Link {
+	var links []Link
+
+	if contact := bio.Contact; contact != nil {
+		if contact.Location != "" {
+			links = append(links, Link{Text: contact.Location})
+		}
+
+		if contact.Email != "" {
+			links = append(links, Link{Text: contact.Email, URL: "mailto:" + contact.Email})
+		}
+
+		if contact.Phone != "" {
+			links = append(links, Link{Text: contact.Phone})
+		}
+
+		if contact.Website != "" {
+			links = append(links, Link{Text: displayURL(contact.Website), URL: contact.Website})
+		}
+
+		for _, social := range contact.Socials {
+			links = append(links, Link{Text: displayURL(social), URL: social})
+		}
+	}
+
+	for _, data := range bio.CustomData {
+		text := data.Value
+		if data.Label != "" {
+			text = data.Label + ": " + data.Value
+		}
+
+		links = append(links, Link{Text: text})
+	}
+
+	return links
+}
+
+//nolint:funlen
+func newSections(schema *types.Schema, name types.SectionName) []Section {
+	switch name {
+	case types.SectionNameWorkExperiences:
+		section := Section{Header: schema.WorkExperiences.Header}
+
+		for _, entity := range schema.WorkExperiences.Entities {
+			section.Entries = append(section.Entries, Entry{
+				Title:    entity.Title,
+				Subtitle: entity.Company,
+				Meta:     nonEmpty(DateRange(entity.StartDate, entity.EndDate), entity.Location),
+				Summary:  strings.Join(entity.Technologies, ", "),
+				Details:  detailTexts(entity.Details),
+			})
+		}
+
+		return []Section{section}
+
+	case types.SectionNameEducations:
+		section := Section{Header: schema.Educations.Header}
+
+		for _, entity := range schema.Educations.Entities {
+			section.Entries = append(section.Entries, Entry{
+				Title:    entity.Degree + ", " + entity.Field,
+				Subtitle: entity.University,
+				Meta:     nonEmpty(DateRange(entity.StartDate, entity.EndDate), entity.Location),
+				Summary:  strings.Join(entity.Technologies, ", "),
+				Details:  detailTexts(entity.Details),
+			})
+		}
+
+		return []Section{section}
+
+	case types.SectionNameCertificates:
+		section := Section{Header: schema.Certificates.Header}
+
+		for _, entity := range schema.Certificates.Entities {
+			section.Entries = append(section.Entries, Entry{
+				Title:    entity.Title,
+				Subtitle: entity.Issuer,
+				Meta:     nonEmpty(DateRange(entity.IssueDate, entity.ExpirationDate)),
+			})
+		}
+
+		return []Section{section}
+
+	case types.SectionNamePublications:
+		section := Section{Header: schema.Publications.Header}
+
+		for _, entity := range schema.Publications.Entities {
+			section.Entries = append(section.Entries, Entry{
+				Title:    entity.Title,
+				Link:     entity.Link,
+				Subtitle: entity.Publisher,
+				Meta:     nonEmpty(string(entity.PublishDate), strings.Join(entity.Authors, ", ")),
+				Details:  detailTexts(entity.Details),
+			})
+		}
+
+		return []Section{section}
+
+	case types.SectionNameSkills:
+		section := Section{Header: schema.Skills.Header}
+
+		for _, entity := range schema.Skills.Entities {
+			items := make([]string, 0, len(entity.Items))
+
+			for _, item := range entity.Items {
+				items = append(items, item.Name)
+			}
+
+			section.Entries = append(section.Entries, Entry{
+				Title:   entity.Category,
+				Summary: strings.Join(items, ", "),
+			})
+		}
+
+		return []Section{section}
+
+	case types.SectionNameProjects:
+		section := Section{Header: schema.Projects.Header}
+
+		for _, entity := range schema.Projects.Entities {
+			section.Entries = append(section.Entries, Entry{
+				Title:   entity.Title,
+				Link:    entity.Link,
+				Details: detailTexts(entity.Details),
+			})
+		}
+
+		return []Section{section}
+
+	case types.SectionNameCustomSections:
+		sections := make([]Section, 0, len(schema.CustomSections))
+
+		for _, custom := range schema.CustomSections {
+			sections = append(sections, Section{
+				Header:  custom.Header,
+				Entries: []Entry{{Details: detailTexts(custom.Details)}},
+			})
+		}
+
+		return sections
+	}
+
+	return nil
+}
+
+// DateRange joins the start and end dates (e.g. "2020-01 – Present"). Empty dates are omitted.
+func DateRange(start types.Date, end types.Date) string {
+	dates := nonEmpty(displayDate(start), displayDate(end))
+
+	return strings.Join(dates, " – ")
+}
+
+func displayDate(date types.Date) string {
+	if date.IsPresent() {
+		return date.Format("")
+	}
+
+	return strings.TrimSpace(string(date))
+}
+
+// displayURL removes the scheme and the trailing slash of the URL to make it readable.
+func displayURL(link string) string {
+	link = strings.TrimPrefix(strings.TrimPrefix(link, "https://"), "http://")
+
+	return strings.TrimSuffix(strings.TrimPrefix(link, "www."), "/")
+}
+
+func detailTexts(details []types.SchemaDetail) []string {
+	texts := make([]string, 0, len(details))
+
+	for _, detail := range details {
+		texts = append(texts, detail.Text)
+	}
+
+	return texts
+}
+
+func nonEmpty(values ...string) []string {
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
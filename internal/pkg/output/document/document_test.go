@@ -0,0 +1,82 @@
+package document_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/document"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Bio: types.SchemaBio{
+			Name:  "John Doe",
+			Title: "Programmer",
+			About: "About John",
+			Contact: &types.SchemaBioContact{
+				Location: "Berlin",
+				Email:    "john@gmail.com",
+				Website:  "https://www.john.dev/",
+				Socials:  []string{"https://github.com/john"},
+			},
+			CustomData: []types.SchemaBioCustomData{{Label: "Visa", Value: "Not required"}},
+		},
+		WorkExperiences: &types.SchemaWorkExperiences{
+			Header: "Work Experiences",
+			Entities: []types.SchemaWorkExperienceEntity{
+				{
+					Title:        "Engineer",
+					Company:      "Acme",
+					Location:     "Remote",
+					StartDate:    "2020-01",
+					EndDate:      types.DatePresent,
+					Details:      types.NewDetails("Built things"),
+					Technologies: []string{"Go", "SQL"},
+				},
+			},
+		},
+		Skills: &types.SchemaSkills{
+			Header: "Skills",
+			Entities: []types.SchemaSkillsEntity{
+				{Category: "Backend", Items: []types.SchemaSkillsEntityItem{{Name: "Go"}, {Name: "gRPC"}}},
+			},
+		},
+		CustomSections: []types.SchemaCustomSection{{Header: "Hobbies", Details: types.NewDetails("Chess")}},
+		Order:          []types.SectionName{types.SectionNameSkills, types.SectionNameCustomSections, types.SectionNameWorkExperiences},
+	}
+
+	require.Equal(
+		t, &document.Document{
+			Name:  "John Doe",
+			Title: "Programmer",
+			About: "About John",
+			Contact: []document.Link{
+				{Text: "Berlin"},
+				{Text: "john@gmail.com", URL: "mailto:john@gmail.com"},
+				{Text: "john.dev", URL: "https://www.john.dev/"},
+				{Text: "github.com/john", URL: "https://github.com/john"},
+				{Text: "Visa: Not required"},
+			},
+			Sections: []document.Section{
+				{Header: "Skills", Entries: []document.Entry{{Title: "Backend", Summary: "Go, gRPC"}}},
+				{Header: "Hobbies", Entries: []document.Entry{{Details: []string{"Chess"}}}},
+				{
+					Header: "Work Experiences",
+					Entries: []document.Entry{
+						{
+							Title:    "Engineer",
+							Subtitle: "Acme",
+							Meta:     []string{"2020-01 – Present", "Remote"},
+							Summary:  "Go, SQL",
+							Details:  []string{"Built things"},
+						},
+					},
+				},
+			},
+		},
+		document.New(schema),
+	)
+}
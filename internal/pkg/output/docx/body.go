@@ -0,0 +1,185 @@
+package docx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/output/document"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// bodyWriter writes the main part of the document (word/document.xml) and keeps track of
+// the hyperlinks, which are stored as external relationships of the part.
+type bodyWriter struct {
+	builder strings.Builder
+	links   []string
+}
+
+func newBodyWriter() *bodyWriter {
+	return &bodyWriter{}
+}
+
+func (w *bodyWriter) String() string {
+	return w.builder.String()
+}
+
+func (w *bodyWriter) write(doc *document.Document, page types.SchemaPage) {
+	w.builder.WriteString(xml.Header)
+	w.builder.WriteString(`<w:document xmlns:w="` + namespaceMain + `" xmlns:r="` + namespaceRelationships + `"><w:body>`)
+
+	w.paragraph("Title", w.run(doc.Name, ""))
+	w.paragraph("Subtitle", w.run(doc.Title, ""))
+
+	if len(doc.Contact) > 0 {
+		runs := make([]string, 0, len(doc.Contact))
+
+		for _, contact := range doc.Contact {
+			runs = append(runs, w.link(contact.Text, contact.URL))
+		}
+
+		w.paragraph("Contact", strings.Join(runs, w.run(" | ", "")))
+	}
+
+	if doc.About != "" {
+		w.paragraph("", w.run(doc.About, ""))
+	}
+
+	for _, section := range doc.Sections {
+		w.paragraph("Heading1", w.run(section.Header, ""))
+
+		for _, entry := range section.Entries {
+			w.entry(entry)
+		}
+	}
+
+	width, height, margin := pageSettings(page)
+
+	orientation := ""
+	if width > height {
+		orientation = ` w:orient="landscape"`
+	}
+
+	fmt.Fprintf(
+		&w.builder,
+		`<w:sectPr><w:pgSz w:w="%d" w:h="%d"%s/>`+
+			`<w:pgMar w:top="%d" w:right="%d" w:bottom="%d" w:left="%d" w:header="0" w:footer="0" w:gutter="0"/>`+
+			`</w:sectPr>`,
+		width, height, orientation, margin[0], margin[1], margin[2], margin[3],
+	)
+
+	w.builder.WriteString(`</w:body></w:document>`)
+}
+
+func (w *bodyWriter) entry(entry document.Entry) {
+	if entry.Title != "" {
+		w.paragraph("Heading2", w.link(entry.Title, entry.Link))
+	}
+
+	var runs []string
+
+	if entry.Subtitle != "" {
+		runs = append(runs, w.run(entry.Subtitle, "<w:b/>"))
+	}
+
+	for _, meta := range entry.Meta {
+		if len(runs) > 0 {
+			runs = append(runs, w.run(" | ", ""))
+		}
+
+		runs = append(runs, w.run(meta, "<w:i/>"))
+	}
+
+	if len(runs) > 0 {
+		w.paragraph("EntryMeta", strings.Join(runs, ""))
+	}
+
+	if entry.Summary != "" {
+		w.paragraph("", w.run(entry.Summary, ""))
+	}
+
+	for _, detail := range entry.Details {
+		w.builder.WriteString(
+			`<w:p><w:pPr><w:pStyle w:val="ListParagraph"/><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr>`,
+		)
+		w.builder.WriteString(w.run(detail, ""))
+		w.builder.WriteString(`</w:p>`)
+	}
+}
+
+func (w *bodyWriter) paragraph(style string, content string) {
+	w.builder.WriteString(`<w:p>`)
+
+	if style != "" {
+		w.builder.WriteString(`<w:pPr><w:pStyle w:val="` + style + `"/></w:pPr>`)
+	}
+
+	w.builder.WriteString(content)
+	w.builder.WriteString(`</w:p>`)
+}
+
+// run returns a run of text with the provided run properties (e.g. <w:b/>).
+func (w *bodyWriter) run(text string, properties string) string {
+	var run strings.Builder
+
+	run.WriteString(`<w:r>`)
+
+	if properties != "" {
+		run.WriteString(`<w:rPr>` + properties + `</w:rPr>`)
+	}
+
+	run.WriteString(`<w:t xml:space="preserve">`)
+	_ = xml.EscapeText(&run, []byte(text))
+	run.WriteString(`</w:t></w:r>`)
+
+	return run.String()
+}
+
+// link returns a hyperlink to the URL, or a plain run if there is no URL.
+func (w *bodyWriter) link(text string, url string) string {
+	if url == "" {
+		return w.run(text, "")
+	}
+
+	w.links = append(w.links, url)
+
+	return fmt.Sprintf(
+		`<w:hyperlink r:id="%s">%s</w:hyperlink>`,
+		linkRelationshipID(len(w.links)-1), w.run(text, `<w:rStyle w:val="Hyperlink"/>`),
+	)
+}
+
+// relationships returns the relationships of the main part, including the hyperlinks.
+func (w *bodyWriter) relationships() string {
+	var rels strings.Builder
+
+	rels.WriteString(xml.Header)
+	rels.WriteString(`<Relationships xmlns="` + namespacePackageRelationships + `">`)
+	rels.WriteString(`<Relationship Id="rIdStyles" Type="` + namespaceRelationships + `/styles" Target="styles.xml"/>`)
+	rels.WriteString(
+		`<Relationship Id="rIdNumbering" Type="` + namespaceRelationships + `/numbering" Target="numbering.xml"/>`,
+	)
+
+	for i, link := range w.links {
+		fmt.Fprintf(
+			&rels, `<Relationship Id="%s" Type="%s/hyperlink" Target="%s" TargetMode="External"/>`,
+			linkRelationshipID(i), namespaceRelationships, escapeXML(link),
+		)
+	}
+
+	rels.WriteString(`</Relationships>`)
+
+	return rels.String()
+}
+
+func linkRelationshipID(index int) string {
+	return fmt.Sprintf("rIdLink%d", index+1)
+}
+
+func escapeXML(value string) string {
+	var escaped strings.Builder
+
+	_ = xml.EscapeText(&escaped, []byte(value))
+
+	return escaped.String()
+}
@@ -0,0 +1,89 @@
+// Package docx generates Office Open XML (Word) documents from the schema
+// without depending on any external binary.
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/seinshah/civic/internal/pkg/output/document"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// twipsPerInch is the number of twentieths of a point in an inch, the unit of the page
+// dimensions in Word documents.
+const twipsPerInch = 1440
+
+// defaultMargin is the margin of all sides in inch when the schema does not define any margin.
+// Unlike the templates that pad their content, the document content would touch the page edges.
+const defaultMargin = 0.5
+
+type Engine struct{}
+
+var _ types.SchemaGenerator = &Engine{}
+
+func NewEngine() *Engine {
+	return &Engine{}
+}
+
+// GenerateFromSchema generates a Word document with the page size and margins of the schema.
+func (e Engine) GenerateFromSchema(_ context.Context, schema *types.Schema) ([]byte, error) {
+	doc := document.New(schema)
+	body := newBodyWriter()
+
+	body.write(doc, schema.Page)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: contentTypesXML},
+		{name: "_rels/.rels", content: packageRelsXML},
+		{name: "docProps/core.xml", content: coreXML(doc)},
+		{name: "word/document.xml", content: body.String()},
+		{name: "word/styles.xml", content: stylesXML},
+		{name: "word/numbering.xml", content: numberingXML},
+		{name: "word/_rels/document.xml.rels", content: body.relationships()},
+	}
+
+	var output bytes.Buffer
+
+	archive := zip.NewWriter(&output)
+
+	for _, part := range parts {
+		writer, err := archive.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", part.name, err)
+		}
+
+		if _, err = writer.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to create the document: %w", err)
+	}
+
+	return output.Bytes(), nil
+}
+
+// pageSettings returns the page size and margins (top, right, bottom, left) in twips.
+func pageSettings(page types.SchemaPage) (int, int, [4]int) {
+	margin := page.Margin
+	if margin == (types.PageMargin{}) {
+		margin = types.PageMargin{Top: defaultMargin, Right: defaultMargin, Bottom: defaultMargin, Left: defaultMargin}
+	}
+
+	width, height := page.Layout().Dimensions()
+
+	return twips(width), twips(height),
+		[4]int{twips(margin.Top), twips(margin.Right), twips(margin.Bottom), twips(margin.Left)}
+}
+
+func twips(inch types.Length) int {
+	return int(math.Round(float64(inch) * twipsPerInch))
+}
@@ -0,0 +1,166 @@
+package docx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/docx"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_GenerateFromSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Page: types.SchemaPage{Size: types.PageSizeLetter, Margin: types.PageMargin{Top: 1, Right: 0.5}},
+		Bio: types.SchemaBio{
+			Name:    "John Doe",
+			Title:   "Programmer",
+			Contact: &types.SchemaBioContact{Email: "john@gmail.com", Socials: []string{"https://github.com/john"}},
+		},
+		WorkExperiences: &types.SchemaWorkExperiences{
+			Header: "Work Experiences",
+			Entities: []types.SchemaWorkExperienceEntity{
+				{
+					Title:     "Engineer",
+					Company:   "R&D <Company>",
+					StartDate: "2020-01",
+					EndDate:   types.DatePresent,
+					Details:   types.NewDetails("Built the payment service"),
+				},
+			},
+		},
+		Projects: &types.SchemaProjects{
+			Header:   "Projects",
+			Entities: []types.SchemaProjectsEntity{{Title: "Civic", Link: "https://github.com/seinshah/civic?a=1&b=2"}},
+		},
+	}
+
+	output, err := docx.NewEngine().GenerateFromSchema(t.Context(), schema)
+
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(output), int64(len(output)))
+
+	require.NoError(t, err)
+
+	parts := map[string]string{}
+
+	for _, file := range archive.File {
+		reader, err := file.Open()
+
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(reader)
+
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		requireWellFormed(t, file.Name, content)
+
+		parts[file.Name] = string(content)
+	}
+
+	require.ElementsMatch(
+		t, []string{
+			"[Content_Types].xml", "_rels/.rels", "docProps/core.xml", "word/document.xml",
+			"word/styles.xml", "word/numbering.xml", "word/_rels/document.xml.rels",
+		},
+		slices.Collect(maps.Keys(parts)),
+	)
+
+	body := parts["word/document.xml"]
+
+	require.Contains(t, body, `<w:pStyle w:val="Title"/></w:pPr><w:r><w:t xml:space="preserve">John Doe</w:t>`)
+	require.Contains(t, body, `<w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t xml:space="preserve">Work Experiences`)
+	require.Contains(t, body, `R&amp;D &lt;Company&gt;`)
+	require.Contains(t, body, `2020-01 – Present`)
+	require.Contains(t, body, `<w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">Built the payment service`)
+	require.Contains(t, body, `<w:pgSz w:w="12240" w:h="15840"/>`)
+	require.Contains(t, body, `<w:pgMar w:top="1440" w:right="720" w:bottom="0" w:left="0"`)
+	require.Contains(t, body, `<w:hyperlink r:id="rIdLink3">`)
+
+	rels := parts["word/_rels/document.xml.rels"]
+
+	require.Contains(t, rels, `Id="rIdLink1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="mailto:john@gmail.com"`)
+	require.Contains(t, rels, `Id="rIdLink3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="https://github.com/seinshah/civic?a=1&amp;b=2"`)
+	require.Contains(t, parts["docProps/core.xml"], "<dc:title>John Doe - Programmer</dc:title>")
+}
+
+func TestEngine_GenerateFromSchema_DefaultMargin(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Page: types.SchemaPage{Size: types.PageSizeA4},
+		Bio:  types.SchemaBio{Name: "John Doe", Title: "Programmer"},
+	}
+
+	output, err := docx.NewEngine().GenerateFromSchema(t.Context(), schema)
+
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(output), int64(len(output)))
+
+	require.NoError(t, err)
+
+	file, err := archive.Open("word/document.xml")
+
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(file)
+
+	require.NoError(t, err)
+	require.Contains(t, string(content), `<w:pgSz w:w="11909" w:h="16834"/>`)
+	require.Contains(t, string(content), `<w:pgMar w:top="720" w:right="720" w:bottom="720" w:left="720"`)
+}
+
+func TestEngine_GenerateFromSchema_Landscape(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Page: types.SchemaPage{
+			Size:        types.PageSizeLetter,
+			Orientation: types.PageOrientationLandscape,
+			Margin:      types.PageMargin{Top: 0.5, Right: 0.5, Bottom: 0.5, Left: 0.5},
+		},
+		Bio: types.SchemaBio{Name: "John Doe", Title: "Programmer"},
+	}
+
+	output, err := docx.NewEngine().GenerateFromSchema(t.Context(), schema)
+
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(output), int64(len(output)))
+
+	require.NoError(t, err)
+
+	file, err := archive.Open("word/document.xml")
+
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(file)
+
+	require.NoError(t, err)
+	require.Contains(t, string(content), `<w:pgSz w:w="15840" w:h="12240" w:orient="landscape"/>`)
+}
+
+func requireWellFormed(t *testing.T, name string, content []byte) {
+	t.Helper()
+
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		require.NoError(t, err, name)
+	}
+}
@@ -0,0 +1,88 @@
+package docx
+
+import (
+	"encoding/xml"
+
+	"github.com/seinshah/civic/internal/pkg/output/document"
+)
+
+const (
+	namespaceMain                 = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	namespaceRelationships        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	namespacePackageRelationships = "http://schemas.openxmlformats.org/package/2006/relationships"
+)
+
+const contentTypesXML = xml.Header +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/word/document.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/word/numbering.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+	`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+	`</Types>`
+
+const packageRelsXML = xml.Header +
+	`<Relationships xmlns="` + namespacePackageRelationships + `">` +
+	`<Relationship Id="rIdDocument" Type="` + namespaceRelationships + `/officeDocument" Target="word/document.xml"/>` +
+	`<Relationship Id="rIdCore" ` +
+	`Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" ` +
+	`Target="docProps/core.xml"/>` +
+	`</Relationships>`
+
+// stylesXML defines the paragraph styles used in the document. Sizes are in half-points
+// and spacings are in twips.
+const stylesXML = xml.Header +
+	`<w:styles xmlns:w="` + namespaceMain + `">` +
+	`<w:docDefaults><w:rPrDefault><w:rPr>` +
+	`<w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:eastAsia="Calibri" w:cs="Calibri"/>` +
+	`<w:sz w:val="21"/><w:szCs w:val="21"/></w:rPr></w:rPrDefault>` +
+	`<w:pPrDefault><w:pPr><w:spacing w:after="60" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault>` +
+	`</w:docDefaults>` +
+	`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/>` +
+	`<w:next w:val="Subtitle"/><w:qFormat/><w:pPr><w:spacing w:after="0"/></w:pPr>` +
+	`<w:rPr><w:b/><w:sz w:val="48"/><w:szCs w:val="48"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Subtitle"><w:name w:val="Subtitle"/><w:basedOn w:val="Normal"/>` +
+	`<w:qFormat/><w:pPr><w:spacing w:after="120"/></w:pPr>` +
+	`<w:rPr><w:color w:val="595959"/><w:sz w:val="28"/><w:szCs w:val="28"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Contact"><w:name w:val="Contact"/><w:basedOn w:val="Normal"/>` +
+	`<w:pPr><w:spacing w:after="200"/></w:pPr><w:rPr><w:sz w:val="19"/><w:szCs w:val="19"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/>` +
+	`<w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/>` +
+	`<w:pBdr><w:bottom w:val="single" w:sz="6" w:space="1" w:color="808080"/></w:pBdr>` +
+	`<w:spacing w:before="240" w:after="80"/><w:outlineLvl w:val="0"/></w:pPr>` +
+	`<w:rPr><w:b/><w:caps/><w:sz w:val="26"/><w:szCs w:val="26"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/><w:basedOn w:val="Normal"/>` +
+	`<w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="120" w:after="0"/>` +
+	`<w:outlineLvl w:val="1"/></w:pPr><w:rPr><w:b/><w:sz w:val="22"/><w:szCs w:val="22"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="EntryMeta"><w:name w:val="Entry Meta"/><w:basedOn w:val="Normal"/>` +
+	`<w:pPr><w:keepNext/></w:pPr><w:rPr><w:color w:val="595959"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="ListParagraph"><w:name w:val="List Paragraph"/>` +
+	`<w:basedOn w:val="Normal"/><w:qFormat/><w:pPr><w:spacing w:after="20"/><w:ind w:left="360"/></w:pPr></w:style>` +
+	`<w:style w:type="character" w:styleId="Hyperlink"><w:name w:val="Hyperlink"/>` +
+	`<w:rPr><w:color w:val="0563C1"/><w:u w:val="single"/></w:rPr></w:style>` +
+	`</w:styles>`
+
+// numberingXML defines the bullet list (numId 1) used for the details.
+const numberingXML = xml.Header +
+	`<w:numbering xmlns:w="` + namespaceMain + `">` +
+	`<w:abstractNum w:abstractNumId="0"><w:multiLevelType w:val="singleLevel"/>` +
+	`<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="•"/><w:lvlJc w:val="left"/>` +
+	`<w:pPr><w:ind w:left="360" w:hanging="240"/></w:pPr></w:lvl></w:abstractNum>` +
+	`<w:num w:numId="1"><w:abstractNumId w:val="0"/></w:num>` +
+	`</w:numbering>`
+
+// coreXML returns the document properties, so the document title and author are
+// shown by the word processors.
+func coreXML(doc *document.Document) string {
+	return xml.Header +
+		`<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" ` +
+		`xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+		`<dc:title>` + escapeXML(doc.Name+" - "+doc.Title) + `</dc:title>` +
+		`<dc:creator>` + escapeXML(doc.Name) + `</dc:creator>` +
+		`</cp:coreProperties>`
+}
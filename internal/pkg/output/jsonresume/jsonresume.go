@@ -0,0 +1,22 @@
+package jsonresume
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/seinshah/civic/internal/pkg/jsonresume"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+type Engine struct{}
+
+var _ types.SchemaGenerator = &Engine{}
+
+func NewEngine() *Engine {
+	return &Engine{}
+}
+
+// GenerateFromSchema generates a resume.json document following the JSON Resume schema.
+func (e Engine) GenerateFromSchema(_ context.Context, schema *types.Schema) ([]byte, error) {
+	return json.MarshalIndent(jsonresume.FromSchema(schema), "", "  ")
+}
@@ -0,0 +1,35 @@
+package jsonresume_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/jsonresume"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_GenerateFromSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Bio: types.SchemaBio{Name: "John Doe", Title: "Programmer"},
+		Skills: &types.SchemaSkills{
+			Entities: []types.SchemaSkillsEntity{
+				{Category: "Web", Items: []types.SchemaSkillsEntityItem{{Name: "HTML"}}},
+			},
+		},
+	}
+
+	output, err := jsonresume.NewEngine().GenerateFromSchema(t.Context(), schema)
+
+	require.NoError(t, err)
+	require.JSONEq(
+		t,
+		`{
+			"$schema": "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json",
+			"basics": {"name": "John Doe", "label": "Programmer"},
+			"skills": [{"name": "Web", "keywords": ["HTML"]}]
+		}`,
+		string(output),
+	)
+}
@@ -0,0 +1,22 @@
+// Package latex writes the LaTeX documents rendered from the LaTeX templates.
+// The documents are not compiled, so they can be tweaked before being compiled
+// with the LaTeX distribution of choice (e.g. pdflatex cv.tex).
+package latex
+
+import (
+	"context"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+type Engine struct{}
+
+var _ types.OutputGenerator = &Engine{}
+
+func NewEngine() *Engine {
+	return &Engine{}
+}
+
+func (e Engine) Generate(_ context.Context, content []byte) ([]byte, error) {
+	return content, nil
+}
@@ -0,0 +1,21 @@
+package latex_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/latex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_Generate(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`\documentclass{moderncv}`)
+
+	engine := latex.NewEngine()
+
+	output, err := engine.Generate(t.Context(), content)
+
+	require.NoError(t, err)
+	require.Equal(t, content, output)
+}
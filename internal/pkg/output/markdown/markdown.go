@@ -0,0 +1,104 @@
+// Package markdown generates Markdown documents from the schema.
+package markdown
+
+import (
+	"context"
+	"strings"
+
+	"github.com/seinshah/civic/internal/pkg/output/document"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+type Engine struct{}
+
+var _ types.SchemaGenerator = &Engine{}
+
+func NewEngine() *Engine {
+	return &Engine{}
+}
+
+//nolint:gochecknoglobals
+var escaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `#`, `\#`, `|`, `\|`,
+)
+
+// GenerateFromSchema generates a Markdown document with a heading per section and
+// a bullet list per entry details.
+func (e Engine) GenerateFromSchema(_ context.Context, schema *types.Schema) ([]byte, error) {
+	doc := document.New(schema)
+
+	var builder strings.Builder
+
+	builder.WriteString("# " + escape(doc.Name) + "\n\n")
+	builder.WriteString("**" + escape(doc.Title) + "**\n")
+
+	if len(doc.Contact) > 0 {
+		contacts := make([]string, 0, len(doc.Contact))
+
+		for _, contact := range doc.Contact {
+			contacts = append(contacts, link(contact.Text, contact.URL))
+		}
+
+		builder.WriteString("\n" + strings.Join(contacts, " · ") + "\n")
+	}
+
+	if doc.About != "" {
+		builder.WriteString("\n" + escape(doc.About) + "\n")
+	}
+
+	for _, section := range doc.Sections {
+		builder.WriteString("\n## " + escape(section.Header) + "\n")
+
+		for _, entry := range section.Entries {
+			writeEntry(&builder, entry)
+		}
+	}
+
+	return []byte(builder.String()), nil
+}
+
+func writeEntry(builder *strings.Builder, entry document.Entry) {
+	if entry.Title != "" {
+		builder.WriteString("\n### " + link(entry.Title, entry.Link) + "\n")
+	}
+
+	var meta []string
+
+	if entry.Subtitle != "" {
+		meta = append(meta, "**"+escape(entry.Subtitle)+"**")
+	}
+
+	for _, value := range entry.Meta {
+		meta = append(meta, "*"+escape(value)+"*")
+	}
+
+	if len(meta) > 0 {
+		builder.WriteString("\n" + strings.Join(meta, " · ") + "\n")
+	}
+
+	if entry.Summary != "" {
+		builder.WriteString("\n" + escape(entry.Summary) + "\n")
+	}
+
+	if len(entry.Details) > 0 {
+		builder.WriteString("\n")
+
+		for _, detail := range entry.Details {
+			builder.WriteString("- " + escape(detail) + "\n")
+		}
+	}
+}
+
+func link(text string, url string) string {
+	if url == "" {
+		return escape(text)
+	}
+
+	return "[" + escape(text) + "](<" + url + ">)"
+}
+
+// escape escapes the characters having a meaning in Markdown and joins the lines,
+// so the text cannot break the structure of the document.
+func escape(text string) string {
+	return escaper.Replace(strings.Join(strings.Fields(text), " "))
+}
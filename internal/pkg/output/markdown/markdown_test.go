@@ -0,0 +1,67 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/markdown"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_GenerateFromSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Bio: types.SchemaBio{
+			Name:    "John Doe",
+			Title:   "Programmer",
+			About:   "Likes *bold* ideas\nand [brackets].",
+			Contact: &types.SchemaBioContact{Email: "john@gmail.com", Socials: []string{"https://github.com/john"}},
+		},
+		WorkExperiences: &types.SchemaWorkExperiences{
+			Header: "Work Experiences",
+			Entities: []types.SchemaWorkExperienceEntity{
+				{
+					Title:     "Engineer",
+					Company:   "Acme",
+					StartDate: "2020-01",
+					EndDate:   types.DatePresent,
+					Details:   types.NewDetails("Built the payment_service", "Mentored #juniors"),
+				},
+			},
+		},
+		Projects: &types.SchemaProjects{
+			Header:   "Projects",
+			Entities: []types.SchemaProjectsEntity{{Title: "Civic", Link: "https://github.com/seinshah/civic"}},
+		},
+	}
+
+	output, err := markdown.NewEngine().GenerateFromSchema(t.Context(), schema)
+
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`# John Doe
+
+**Programmer**
+
+[john@gmail.com](<mailto:john@gmail.com>) · [github.com/john](<https://github.com/john>)
+
+Likes \*bold\* ideas and \[brackets\].
+
+## Work Experiences
+
+### Engineer
+
+**Acme** · *2020-01 – Present*
+
+- Built the payment\_service
+- Mentored \#juniors
+
+## Projects
+
+### [Civic](<https://github.com/seinshah/civic>)
+`,
+		string(output),
+	)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/seinshah/civic/internal/pkg/types"
 )
@@ -19,8 +21,77 @@ func Render(
 		return err
 	}
 
-	if err = os.WriteFile(outputPath, output, types.DefaultFilePermission); err != nil {
-		return fmt.Errorf("failed to write PDF to file: %w", err)
+	return write(output, outputPath)
+}
+
+// RenderWithReport generates the output like Render, and returns the layout of the printed
+// document reported by the generator.
+func RenderWithReport(
+	ctx context.Context,
+	content []byte,
+	engine types.ReportingOutputGenerator,
+	outputPath string,
+) (*types.LayoutReport, error) {
+	output, report, err := engine.GenerateWithReport(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, write(output, outputPath)
+}
+
+// RenderPages generates one output per page and writes each of them next to the output path
+// with the page number added before its extension (e.g. cv-1.png). It returns the written paths.
+func RenderPages(
+	ctx context.Context,
+	content []byte,
+	engine types.PagedOutputGenerator,
+	outputPath string,
+) ([]string, error) {
+	pages, err := engine.GeneratePages(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := filepath.Ext(outputPath)
+	paths := make([]string, 0, len(pages))
+
+	for i, page := range pages {
+		pagePath := fmt.Sprintf("%s-%d%s", strings.TrimSuffix(outputPath, ext), i+1, ext)
+
+		if err = write(page, pagePath); err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, pagePath)
+	}
+
+	return paths, nil
+}
+
+// RenderSchema generates the output directly from the schema and writes it to the output path.
+func RenderSchema(
+	ctx context.Context,
+	schema *types.Schema,
+	engine types.SchemaGenerator,
+	outputPath string,
+) error {
+	output, err := engine.GenerateFromSchema(ctx, schema)
+	if err != nil {
+		return err
+	}
+
+	return write(output, outputPath)
+}
+
+func write(output []byte, outputPath string) error {
+	// output path patterns (e.g. "{{.Profile}}/cv.pdf") might point to directories that do not exist yet.
+	if err := os.MkdirAll(filepath.Dir(outputPath), types.DefaultDirPermission); err != nil {
+		return fmt.Errorf("failed to create the output directory: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, output, types.DefaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write the output to file: %w", err)
 	}
 
 	return nil
@@ -1,11 +1,17 @@
 package output_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/seinshah/civic/internal/pkg/output"
 	"github.com/seinshah/civic/internal/pkg/output/html"
+	"github.com/seinshah/civic/internal/pkg/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,3 +36,53 @@ func TestRender(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, content, actualContent)
 }
+
+type pagedEngine struct{}
+
+func (pagedEngine) GeneratePages(_ context.Context, content []byte) ([][]byte, error) {
+	return bytes.Split(content, []byte("|")), nil
+}
+
+func TestRenderPages(t *testing.T) {
+	t.Parallel()
+
+	outputPath := filepath.Join(t.TempDir(), "cv.png")
+
+	paths, err := output.RenderPages(t.Context(), []byte("page1|page2"), pagedEngine{}, outputPath)
+
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{strings.TrimSuffix(outputPath, ".png") + "-1.png", strings.TrimSuffix(outputPath, ".png") + "-2.png"},
+		paths,
+	)
+
+	for i, path := range paths {
+		actualContent, err := os.ReadFile(path)
+
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("page%d", i+1), string(actualContent))
+	}
+}
+
+type reportingEngine struct{}
+
+func (reportingEngine) GenerateWithReport(_ context.Context, content []byte) ([]byte, *types.LayoutReport, error) {
+	return content, &types.LayoutReport{Pages: 2, Scale: 1}, nil
+}
+
+func TestRenderWithReport(t *testing.T) {
+	t.Parallel()
+
+	outputPath := filepath.Join(t.TempDir(), "cv.pdf")
+
+	report, err := output.RenderWithReport(t.Context(), []byte("%PDF-1.4"), reportingEngine{}, outputPath)
+
+	require.NoError(t, err)
+	require.Equal(t, &types.LayoutReport{Pages: 2, Scale: 1}, report)
+
+	actualContent, err := os.ReadFile(outputPath)
+
+	require.NoError(t, err)
+	require.Equal(t, "%PDF-1.4", string(actualContent))
+}
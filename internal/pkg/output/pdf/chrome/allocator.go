@@ -0,0 +1,67 @@
+package chrome
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chromedp/chromedp"
+)
+
+// allocatorOptions select the browser the generators render in.
+type allocatorOptions struct {
+	remoteURL string
+	execPath  string
+	flags     []string
+}
+
+// WithRemoteURL connects to a running browser (e.g. a sidecar container) instead of launching
+// one. The URL is either the DevTools websocket URL of the browser
+// (e.g. ws://127.0.0.1:9222/devtools/browser/<id>) or its DevTools HTTP address
+// (e.g. http://127.0.0.1:9222). The executable path and the launch flags are ignored,
+// and the browser is left running when the generator is closed.
+func WithRemoteURL(url string) Option {
+	return func(o *options) {
+		o.allocator.remoteURL = url
+	}
+}
+
+// WithExecPath sets the path to the executable of the browser to launch.
+// By default, Chrome or Chromium is looked up in the common locations.
+func WithExecPath(path string) Option {
+	return func(o *options) {
+		o.allocator.execPath = path
+	}
+}
+
+// WithLaunchFlags adds the flags to the command line of the browser to launch, on top of
+// the default ones. The flags are in the --name or --name=value form (e.g. --no-sandbox).
+func WithLaunchFlags(flags ...string) Option {
+	return func(o *options) {
+		o.allocator.flags = append(o.allocator.flags, flags...)
+	}
+}
+
+// newContext returns the context allocating the browser.
+func (a allocatorOptions) newContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if a.remoteURL != "" {
+		return chromedp.NewRemoteAllocator(ctx, a.remoteURL)
+	}
+
+	opts := append([]chromedp.ExecAllocatorOption{}, chromedp.DefaultExecAllocatorOptions[:]...)
+
+	if a.execPath != "" {
+		opts = append(opts, chromedp.ExecPath(a.execPath))
+	}
+
+	for _, flag := range a.flags {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(flag, "-"), "=")
+
+		if hasValue {
+			opts = append(opts, chromedp.Flag(name, value))
+		} else {
+			opts = append(opts, chromedp.Flag(name, true))
+		}
+	}
+
+	return chromedp.NewExecAllocator(ctx, opts...)
+}
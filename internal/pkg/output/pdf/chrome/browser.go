@@ -0,0 +1,314 @@
+package chrome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/chromedp/chromedp"
+)
+
+var (
+	ErrBrowserClosed      = errors.New("headless browser is closed")
+	ErrBrowserUnreachable = errors.New(
+		"no headless browser is reachable (install Chrome or Chromium, provide the path to its executable, " +
+			"or provide the URL of a running browser)",
+	)
+)
+
+// Browser is a headless browser kept alive to be shared between the generators,
+// so each generated output opens a new tab instead of launching a new browser.
+// The browser is launched again if it crashes.
+type Browser struct {
+	// parent is the context the browser is launched in, and launched again after a crash.
+	parent    context.Context //nolint:containedctx
+	allocator allocatorOptions
+
+	mu     sync.Mutex
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	closed bool
+
+	// tabs bounds the number of tabs open at once. It is nil if the tabs are not bounded.
+	tabs chan struct{}
+}
+
+// NewBrowser launches a headless browser, or connects to a running one, that lives until the
+// context is done or the browser is closed. At most maxTabs tabs are open at once, and the
+// generators wait for a tab to be closed beyond that. 0 leaves the number of tabs unbounded.
+// Only the options selecting the browser (e.g. WithRemoteURL and WithExecPath) are used.
+func NewBrowser(ctx context.Context, maxTabs int, opts ...Option) (*Browser, error) {
+	var instanceOpts options
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	return newBrowser(ctx, maxTabs, instanceOpts.allocator)
+}
+
+// NewLazyBrowser is like NewBrowser, but the browser is launched when the first tab is opened,
+// so no browser is needed until an output is rendered in it. The launch is retried on the next
+// tab if it fails.
+func NewLazyBrowser(ctx context.Context, maxTabs int, opts ...Option) *Browser {
+	var instanceOpts options
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	return newLazyBrowser(ctx, maxTabs, instanceOpts.allocator)
+}
+
+func newBrowser(ctx context.Context, maxTabs int, allocator allocatorOptions) (*Browser, error) {
+	browser := newLazyBrowser(ctx, maxTabs, allocator)
+
+	if err := browser.launch(); err != nil {
+		return nil, err
+	}
+
+	return browser, nil
+}
+
+func newLazyBrowser(ctx context.Context, maxTabs int, allocator allocatorOptions) *Browser {
+	browser := &Browser{
+		parent:    ctx,
+		allocator: allocator,
+	}
+
+	if maxTabs > 0 {
+		browser.tabs = make(chan struct{}, maxTabs)
+	}
+
+	return browser
+}
+
+// Close closes the browser. The tabs being rendered fail, and no tab can be opened afterward.
+func (b *Browser) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+
+	b.closed = true
+
+	// A lazy browser may not be launched yet.
+	if b.ctx == nil {
+		return nil
+	}
+
+	// A running browser that is connected to is left open, only its tabs are closed.
+	if b.allocator.remoteURL != "" {
+		b.cancel()
+
+		return nil
+	}
+
+	// Cancel closes the browser gracefully, and the context is cancelled either way.
+	err := chromedp.Cancel(b.ctx)
+	b.cancel()
+
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// WithBrowser makes the generator open a tab in the provided browser instead of
+// launching a browser of its own. The browser is not closed with the generator.
+func WithBrowser(browser *Browser) Option {
+	return func(o *options) {
+		o.browser = browser
+	}
+}
+
+// WithMaxTabs sets the maximum number of tabs open at once in the browser owned by
+// the generator. The renders wait for a tab to be closed beyond that.
+// Default is 0, which leaves the number of tabs unbounded.
+func WithMaxTabs(maxTabs int) Option {
+	return func(o *options) {
+		o.maxTabs = maxTabs
+	}
+}
+
+// Start launches the browser if it is lazy and not launched yet, so a browser that cannot be
+// launched fails before any output is rendered instead of on every tab.
+func (b *Browser) Start() error {
+	_, err := b.current()
+
+	return err
+}
+
+// launch launches the browser, or connects to the running one. Running no actions only
+// launches the browser. The caller must hold the lock, unless the browser is not shared yet.
+func (b *Browser) launch() error {
+	allocatorCtx, allocatorCancel := b.allocator.newContext(b.parent)
+	browserCtx, browserCancel := chromedp.NewContext(allocatorCtx)
+
+	cancel := func() {
+		browserCancel()
+		allocatorCancel()
+	}
+
+	if err := chromedp.Run(browserCtx); err != nil {
+		cancel()
+
+		if ctxErr := b.parent.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		return fmt.Errorf("%w: %w", ErrBrowserUnreachable, err)
+	}
+
+	b.ctx, b.cancel = browserCtx, cancel
+
+	return nil
+}
+
+// current returns the context of the running browser. The browser is launched if it is
+// lazy and not launched yet, or launched again if it has crashed, which is the case when its
+// context is done while the parent is not.
+func (b *Browser) current() (context.Context, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrBrowserClosed
+	}
+
+	if b.ctx != nil && b.ctx.Err() == nil {
+		return b.ctx, nil
+	}
+
+	if err := b.parent.Err(); err != nil {
+		return nil, err
+	}
+
+	if b.ctx != nil {
+		slog.Warn("The headless browser crashed, launching a new one")
+
+		b.cancel()
+	}
+
+	if err := b.launch(); err != nil {
+		return nil, err
+	}
+
+	return b.ctx, nil
+}
+
+// run runs the tasks in a new tab, and closes the tab afterward. It waits for a free tab
+// if the tabs are bounded. If the browser crashes during the run, the tasks are run once
+// more in the relaunched browser.
+func (b *Browser) run(ctx context.Context, tasks chromedp.Tasks) error {
+	if b.tabs != nil {
+		select {
+		case b.tabs <- struct{}{}:
+			defer func() { <-b.tabs }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	err := b.runInTab(ctx, tasks)
+	if err == nil || ctx.Err() != nil || !b.crashed() {
+		return err
+	}
+
+	return b.runInTab(ctx, tasks)
+}
+
+func (b *Browser) runInTab(ctx context.Context, tasks chromedp.Tasks) error {
+	browserCtx, err := b.current()
+	if err != nil {
+		return err
+	}
+
+	tabCtx, cancel := chromedp.NewContext(browserCtx)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	if err = chromedp.Run(tabCtx, tasks); err != nil && ctx.Err() != nil {
+		// The tab is closed because the context is done, so its error is more relevant.
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// crashed reports whether the browser is lost while it is not closed.
+func (b *Browser) crashed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return !b.closed && b.ctx != nil && b.ctx.Err() != nil && b.parent.Err() == nil
+}
+
+// browserOwner runs the tasks of a generator in the shared browser, or in a browser owned by
+// the generator. The owned browser is launched on the first run, and kept alive for the next
+// ones until the generator is closed.
+type browserOwner struct {
+	mu     sync.Mutex
+	owned  *Browser
+	closed bool
+}
+
+func (o *browserOwner) run(ctx context.Context, config options, tasks chromedp.Tasks) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if config.browser != nil {
+		return config.browser.run(ctx, tasks)
+	}
+
+	browser, err := o.browser(ctx, config.maxTabs, config.allocator)
+	if err != nil {
+		return err
+	}
+
+	return browser.run(ctx, tasks)
+}
+
+func (o *browserOwner) browser(ctx context.Context, maxTabs int, allocator allocatorOptions) (*Browser, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed {
+		return nil, ErrBrowserClosed
+	}
+
+	if o.owned == nil {
+		// The browser outlives the render launching it, and lives until the generator is closed.
+		browser, err := newBrowser(context.WithoutCancel(ctx), maxTabs, allocator)
+		if err != nil {
+			return nil, err
+		}
+
+		o.owned = browser
+	}
+
+	return o.owned, nil
+}
+
+// Close closes the browser owned by the generator, if any. A shared browser is left open.
+// The generator cannot be used afterward.
+func (o *browserOwner) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.closed = true
+
+	if o.owned == nil {
+		return nil
+	}
+
+	return o.owned.Close()
+}
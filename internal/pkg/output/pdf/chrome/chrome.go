@@ -3,31 +3,69 @@ package chrome
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"sync"
 	"time"
 
+	"github.com/chromedp/cdproto/emulation"
 	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/chromedp"
 	"github.com/go-playground/validator/v10"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/metadata"
 	"github.com/seinshah/civic/internal/pkg/types"
 )
 
 type options struct {
-	pageSize   types.PageSize
-	pageMargin types.PageMargin
+	pageLayout  types.PageLayout
+	pageMargin  types.PageMargin
+	dpi         int
+	imageFormat types.OutputType
+	browser     *Browser
+	maxTabs     int
+	allocator   allocatorOptions
+	metadata    *metadata.Info
+	outline     bool
+	header      *headerFooter
+	footer      *headerFooter
+	maxPages    int
+	minScale    float64
+	noScripts   bool
 }
 
+// Headless prints the content as PDF in a headless browser. The browser is launched on
+// the first render and reused by the next ones, unless a shared browser is provided.
+// Close must be called to close the browser once the renders are done.
 type Headless struct {
+	browserOwner
+
 	config options
 }
 
 type Option func(*options)
 
-var _ types.OutputGenerator = &Headless{}
+var (
+	_ types.OutputGenerator          = &Headless{}
+	_ types.ReportingOutputGenerator = &Headless{}
+)
 
 func WithPageSize(size types.PageSize) Option {
 	return func(o *options) {
-		o.pageSize = size
+		o.pageLayout.Size = size
+	}
+}
+
+// WithPageOrientation sets the orientation of the page. Default is portrait.
+func WithPageOrientation(orientation types.PageOrientation) Option {
+	return func(o *options) {
+		o.pageLayout.Orientation = orientation
+	}
+}
+
+// WithCustomPageSize sets the dimensions of the page, which take precedence over the page size.
+func WithCustomPageSize(width, height types.Length) Option {
+	return func(o *options) {
+		o.pageLayout.Width = width
+		o.pageLayout.Height = height
 	}
 }
 
@@ -37,9 +75,33 @@ func WithPageMargin(margin types.PageMargin) Option {
 	}
 }
 
+// WithMetadata writes the info dictionary and the XMP metadata of the PDF after printing it.
+func WithMetadata(info metadata.Info) Option {
+	return func(o *options) {
+		o.metadata = &info
+	}
+}
+
+// WithDocumentOutline adds the outline (bookmarks) of the PDF, built from the headings of
+// the content (e.g. the section headers).
+func WithDocumentOutline() Option {
+	return func(o *options) {
+		o.outline = true
+	}
+}
+
+// WithoutJavaScript disables the scripts of the content (e.g. the inline event handlers),
+// which is required when the content is not trusted, as the scripts run in the browser
+// rendering it.
+func WithoutJavaScript() Option {
+	return func(o *options) {
+		o.noScripts = true
+	}
+}
+
 func NewHeadless(opts ...Option) *Headless {
 	instanceOpts := options{
-		pageSize: types.DefaultPageSize,
+		pageLayout: types.PageLayout{Size: types.DefaultPageSize},
 	}
 
 	for _, opt := range opts {
@@ -52,53 +114,165 @@ func NewHeadless(opts ...Option) *Headless {
 }
 
 func (h *Headless) Generate(ctx context.Context, content []byte) ([]byte, error) {
-	if !h.config.pageSize.IsValid() {
-		return nil, types.ErrInvalidPageSize
+	return h.generate(ctx, content, nil)
+}
+
+// GenerateWithReport prints the content as PDF like Generate, and reports the number of
+// pages, the sections breaking across pages, and the elements overflowing the page width.
+func (h *Headless) GenerateWithReport(ctx context.Context, content []byte) ([]byte, *types.LayoutReport, error) {
+	report := &types.LayoutReport{}
+
+	output, err := h.generate(ctx, content, report)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return output, report, nil
+}
+
+// generate prints the content as PDF, and fills the report if it is provided.
+func (h *Headless) generate(ctx context.Context, content []byte, report *types.LayoutReport) ([]byte, error) {
+	if err := h.config.pageLayout.Validate(); err != nil {
+		return nil, err
 	}
 
 	if err := validator.New().Struct(h.config.pageMargin); err != nil {
 		return nil, errors.Join(types.ErrInvalidPageMargin, err)
 	}
 
-	newCtx, cancel := chromedp.NewContext(ctx)
-	defer cancel()
+	if err := h.config.pageMargin.Fits(h.config.pageLayout.Dimensions()); err != nil {
+		return nil, errors.Join(types.ErrInvalidPageMargin, err)
+	}
+
+	if err := h.config.validateHeaderFooterMargin(); err != nil {
+		return nil, errors.Join(types.ErrInvalidPageMargin, err)
+	}
+
+	if err := h.config.validateFit(); err != nil {
+		return nil, err
+	}
+
+	var (
+		result, firstPage []byte
+		scale             = 1.0
+	)
 
-	var result []byte
+	allParams, firstPageParams := h.config.printParams()
 
-	printTask := chromedp.Tasks{
+	printAction := h.getPrintToPDFAction(allParams, &scale, &result)
+	if h.config.maxPages > 0 {
+		printAction = h.getFitToPagesAction(allParams, &scale, &result)
+	}
+
+	printTask := append(loadTasks(content, h.config.noScripts), chromedp.ActionFunc(printAction))
+
+	if report != nil {
+		printTask = append(printTask, chromedp.ActionFunc(h.getMeasureLayoutAction(&scale, report)))
+	}
+
+	// The first page is printed at the same scale as the other pages, once it is found.
+	if firstPageParams != nil {
+		printTask = append(
+			printTask, chromedp.ActionFunc(h.getPrintToPDFAction(*firstPageParams, &scale, &firstPage)),
+		)
+	}
+
+	if err := h.run(ctx, h.config, printTask); err != nil {
+		return nil, err
+	}
+
+	if report != nil {
+		pages, err := countPages(result)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Pages = pages
+	}
+
+	if firstPageParams != nil {
+		result = replaceFirstPage(result, firstPage)
+	}
+
+	if h.config.metadata != nil {
+		withMetadata, err := metadata.Write(result, *h.config.metadata)
+		if err != nil {
+			// The metadata is not essential, so the PDF is kept as printed.
+			slog.Warn("Failed to write the PDF metadata", "error", err)
+
+			return result, nil
+		}
+
+		result = withMetadata
+	}
+
+	return result, nil
+}
+
+// loadTasks loads the content in the blank page and waits for the page and its stylesheets
+// to be fully loaded. The scripts of the content are not run if noScripts is set, while
+// the scripts evaluated by the tasks still are.
+func loadTasks(content []byte, noScripts bool) chromedp.Tasks {
+	var tasks chromedp.Tasks
+
+	if noScripts {
+		tasks = append(tasks, emulation.SetScriptExecutionDisabled(true))
+	}
+
+	return append(
+		tasks,
 		chromedp.Navigate("about:blank"),
-		chromedp.ActionFunc(h.getLoadContentAction(string(content))),
+		chromedp.ActionFunc(getLoadContentAction(string(content))),
 		// Wait for the page to be fully loaded
 		chromedp.WaitReady("body", chromedp.ByQuery),
 		// Wait for all stylesheets to be loaded
-		chromedp.ActionFunc(
-			func(ctx context.Context) error {
-				jsPoll := `
-					Array.from(document.querySelectorAll('link[rel="stylesheet"]'))
-						.every(link => link.sheet || link.onload === null)
-				`
-
-				return chromedp.Poll(
-					jsPoll,
-					nil,
-					chromedp.WithPollingInterval(500*time.Millisecond), //nolint:mnd
-					chromedp.WithPollingTimeout(10*time.Second),        //nolint:mnd
-				).Do(ctx)
-			},
-		),
+		chromedp.ActionFunc(waitForStylesheets),
 		// Give a tiny extra buffer for layout/paint
-		chromedp.Sleep(500 * time.Millisecond), //nolint:mnd
-		chromedp.ActionFunc(h.getPrintToPDFAction(&result)),
-	}
+		chromedp.Sleep(500*time.Millisecond), //nolint:mnd
+	)
+}
 
-	if err := chromedp.Run(newCtx, printTask); err != nil {
-		return nil, err
-	}
+// waitForStylesheets waits for the stylesheets of the page to be loaded. The page is polled
+// from here rather than by chromedp.Poll, as its polling runs on the timers of the page,
+// which are not run when the scripts of the content are disabled.
+func waitForStylesheets(ctx context.Context) error {
+	const (
+		interval = 500 * time.Millisecond
+		timeout  = 10 * time.Second
+	)
 
-	return result, nil
+	loaded := `Array.from(document.querySelectorAll('link[rel="stylesheet"]'))
+		.every(link => link.sheet || link.onload === null)`
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		var done bool
+
+		if err := chromedp.Evaluate(loaded, &done).Do(ctx); err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return chromedp.ErrPollingTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
 
-func (h *Headless) getLoadContentAction(html string) func(ctx context.Context) error {
+func getLoadContentAction(html string) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		loadCtx, loadCancel := context.WithCancel(ctx)
 		defer loadCancel()
@@ -131,20 +305,30 @@ func (h *Headless) getLoadContentAction(html string) func(ctx context.Context) e
 	}
 }
 
-func (h *Headless) getPrintToPDFAction(output *[]byte) func(ctx context.Context) error {
+func (h *Headless) getPrintToPDFAction(
+	params printParams,
+	scale *float64,
+	output *[]byte,
+) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		var err error
 
+		width, height := h.config.pageLayout.Dimensions()
+
 		if *output, _, err = page.PrintToPDF().
-			WithDisplayHeaderFooter(false).
+			WithDisplayHeaderFooter(h.config.hasHeaderFooter()).
+			WithHeaderTemplate(params.header).
+			WithFooterTemplate(params.footer).
+			WithPageRanges(params.pageRanges).
+			WithGenerateDocumentOutline(h.config.outline).
 			WithPrintBackground(true).
-			WithScale(1).
-			WithPaperWidth(h.config.pageSize.GetWidthInch()).
-			WithPaperHeight(h.config.pageSize.GetHeightInch()).
-			WithMarginTop(h.config.pageMargin.Top).
-			WithMarginRight(h.config.pageMargin.Right).
-			WithMarginBottom(h.config.pageMargin.Bottom).
-			WithMarginLeft(h.config.pageMargin.Left).
+			WithScale(*scale).
+			WithPaperWidth(float64(width)).
+			WithPaperHeight(float64(height)).
+			WithMarginTop(float64(h.config.pageMargin.Top)).
+			WithMarginRight(float64(h.config.pageMargin.Right)).
+			WithMarginBottom(float64(h.config.pageMargin.Bottom)).
+			WithMarginLeft(float64(h.config.pageMargin.Left)).
 			Do(ctx); err != nil {
 			return err
 		}
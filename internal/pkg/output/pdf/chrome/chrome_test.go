@@ -2,6 +2,7 @@ package chrome_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -61,7 +62,7 @@ func TestEngine_Generate(t *testing.T) {
 				chrome.WithPageMargin(
 					types.PageMargin{
 						Top:    3,
-						Right:  3,
+						Right:  -3,
 						Bottom: 3,
 						Left:   3,
 					},
@@ -70,6 +71,73 @@ func TestEngine_Generate(t *testing.T) {
 			expectError: true,
 			err:         types.ErrInvalidPageMargin,
 		},
+		{
+			name: "page-margin-exceeding-page",
+			options: []chrome.Option{
+				chrome.WithPageSize(types.PageSizeA5),
+				chrome.WithPageMargin(types.PageMargin{Right: 3, Left: 3}),
+			},
+			expectError: true,
+			err:         types.ErrInvalidPageMargin,
+		},
+		{
+			name: "page-margin-exceeding-landscape-page",
+			options: []chrome.Option{
+				chrome.WithPageOrientation(types.PageOrientationLandscape),
+				chrome.WithPageMargin(types.PageMargin{Top: 4, Bottom: 4.5}),
+			},
+			expectError: true,
+			err:         types.ErrInvalidPageMargin,
+		},
+		{
+			name: "invalid-custom-page-size",
+			options: []chrome.Option{
+				chrome.WithCustomPageSize(5, 0),
+			},
+			expectError: true,
+			err:         types.ErrInvalidPageSize,
+		},
+		{
+			name: "negative-max-pages",
+			options: []chrome.Option{
+				chrome.WithMaxPages(-1, 0),
+			},
+			expectError: true,
+			err:         chrome.ErrInvalidMaxPages,
+		},
+		{
+			name: "invalid-min-scale",
+			options: []chrome.Option{
+				chrome.WithMaxPages(1, 0.05),
+			},
+			expectError: true,
+			err:         chrome.ErrInvalidMinScale,
+		},
+		{
+			name: "invalid-page-orientation",
+			options: []chrome.Option{
+				chrome.WithPageOrientation("diagonal"),
+			},
+			expectError: true,
+			err:         types.ErrInvalidPageOrientation,
+		},
+		{
+			name: "no-room-for-header",
+			options: []chrome.Option{
+				chrome.WithHeaderTemplate("<span>header</span>", false),
+			},
+			expectError: true,
+			err:         types.ErrInvalidPageMargin,
+		},
+		{
+			name: "no-room-for-footer",
+			options: []chrome.Option{
+				chrome.WithPageMargin(types.PageMargin{Top: 1, Bottom: 0.2}),
+				chrome.WithFooterTemplate(`<span class="pageNumber"></span>`, true),
+			},
+			expectError: true,
+			err:         types.ErrInvalidPageMargin,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -88,10 +156,12 @@ func TestEngine_Generate(t *testing.T) {
 				}
 
 				engine := chrome.NewHeadless(tc.options...)
+				defer engine.Close()
+
 				output, err := engine.Generate(ctx, []byte("<p>test</p>"))
 
 				if tc.expectError {
-					require.Error(t, err)
+					require.ErrorIs(t, err, tc.err)
 					require.Nil(t, output)
 
 					return
@@ -103,3 +173,238 @@ func TestEngine_Generate(t *testing.T) {
 		)
 	}
 }
+
+func TestScreenshot_Generate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		options []chrome.Option
+		err     error
+	}{
+		{
+			name: "invalid-page-size",
+			options: []chrome.Option{
+				chrome.WithPageSize("invalid"),
+			},
+			err: types.ErrInvalidPageSize,
+		},
+		{
+			name: "zero-dpi",
+			options: []chrome.Option{
+				chrome.WithDPI(0),
+			},
+			err: chrome.ErrInvalidDPI,
+		},
+		{
+			name: "too-high-dpi",
+			options: []chrome.Option{
+				chrome.WithDPI(1200),
+			},
+			err: chrome.ErrInvalidDPI,
+		},
+		{
+			name: "invalid-image-format",
+			options: []chrome.Option{
+				chrome.WithImageFormat(types.OutputTypePdf),
+			},
+			err: chrome.ErrInvalidImageFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				engine := chrome.NewScreenshot(tc.options...)
+
+				output, err := engine.Generate(t.Context(), []byte("<p>test</p>"))
+
+				require.ErrorIs(t, err, tc.err)
+				require.Nil(t, output)
+
+				pages, err := engine.GeneratePages(t.Context(), []byte("<p>test</p>"))
+
+				require.ErrorIs(t, err, tc.err)
+				require.Nil(t, pages)
+			},
+		)
+	}
+}
+
+func TestHeadless_Reuse(t *testing.T) {
+	t.Parallel()
+
+	engine := chrome.NewHeadless(chrome.WithMaxTabs(2))
+	defer engine.Close()
+
+	var wg sync.WaitGroup
+
+	outputs := make([][]byte, 5)
+	errs := make([]error, len(outputs))
+
+	for i := range outputs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			outputs[i], errs[i] = engine.Generate(t.Context(), []byte("<p>test</p>"))
+		}()
+	}
+
+	wg.Wait()
+
+	for i := range outputs {
+		require.NoError(t, errs[i])
+		require.NotEmpty(t, outputs[i])
+	}
+}
+
+func TestHeadless_Close(t *testing.T) {
+	t.Parallel()
+
+	engine := chrome.NewHeadless()
+
+	require.NoError(t, engine.Close())
+	require.NoError(t, engine.Close())
+
+	output, err := engine.Generate(t.Context(), []byte("<p>test</p>"))
+
+	require.ErrorIs(t, err, chrome.ErrBrowserClosed)
+	require.Nil(t, output)
+}
+
+func TestHeadless_GenerateWithReport(t *testing.T) {
+	t.Parallel()
+
+	engine := chrome.NewHeadless(
+		chrome.WithPageSize(types.PageSizeA5),
+		chrome.WithPageMargin(types.PageMargin{Top: 0.5, Right: 0.5, Bottom: 0.5, Left: 0.5}),
+	)
+	defer engine.Close()
+
+	content := `<style>body { margin: 0; }</style><section><h2>Summary</h2><p>short</p></section>` +
+		`<section data-section="experience"><div style="height: 12in"></div></section>` +
+		`<div id="banner" style="width: 10in">wide</div>`
+
+	output, report, err := engine.GenerateWithReport(t.Context(), []byte(content))
+
+	require.NoError(t, err)
+	require.NotEmpty(t, output)
+	require.GreaterOrEqual(t, report.Pages, 2)
+	require.InDelta(t, 1, report.Scale, 1e-9)
+	require.Len(t, report.SplitSections, 1)
+	require.Equal(t, "experience", report.SplitSections[0].Name)
+	require.Len(t, report.Overflows, 1)
+	require.Equal(t, "div#banner", report.Overflows[0].Element)
+	require.InDelta(t, 5.17, float64(report.Overflows[0].Overflow), 0.05)
+}
+
+func TestHeadless_UnreachableBrowser(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		options []chrome.Option
+	}{
+		{
+			name:    "missing-executable",
+			options: []chrome.Option{chrome.WithExecPath("/path/to/missing/chrome")},
+		},
+		{
+			name:    "unreachable-remote-browser",
+			options: []chrome.Option{chrome.WithRemoteURL("http://127.0.0.1:1")},
+		},
+		{
+			name:    "invalid-remote-url",
+			options: []chrome.Option{chrome.WithRemoteURL("ws://127.0.0.1:1/devtools/browser/missing")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				engine := chrome.NewHeadless(tc.options...)
+				defer engine.Close()
+
+				output, err := engine.Generate(t.Context(), []byte("<p>test</p>"))
+
+				require.ErrorIs(t, err, chrome.ErrBrowserUnreachable)
+				require.Nil(t, output)
+
+				_, err = chrome.NewBrowser(t.Context(), 1, tc.options...)
+
+				require.ErrorIs(t, err, chrome.ErrBrowserUnreachable)
+			},
+		)
+	}
+}
+
+func TestLazyBrowser(t *testing.T) {
+	t.Parallel()
+
+	// The missing browser is only reported when an output is rendered in it.
+	browser := chrome.NewLazyBrowser(t.Context(), 1, chrome.WithExecPath("/path/to/missing/chrome"))
+
+	engine := chrome.NewHeadless(chrome.WithBrowser(browser))
+	defer engine.Close()
+
+	for range 2 {
+		output, err := engine.Generate(t.Context(), []byte("<p>test</p>"))
+
+		require.ErrorIs(t, err, chrome.ErrBrowserUnreachable)
+		require.Nil(t, output)
+	}
+
+	require.ErrorIs(t, browser.Start(), chrome.ErrBrowserUnreachable)
+	require.NoError(t, browser.Close())
+	require.ErrorIs(t, browser.Start(), chrome.ErrBrowserClosed)
+
+	output, err := engine.Generate(t.Context(), []byte("<p>test</p>"))
+
+	require.ErrorIs(t, err, chrome.ErrBrowserClosed)
+	require.Nil(t, output)
+}
+
+func TestHeadless_WithoutJavaScript(t *testing.T) {
+	t.Parallel()
+
+	// The inline handler adds an element overflowing the page, which is reported if it is run.
+	content := `<img src="missing.png" onerror="document.body.insertAdjacentHTML('beforeend', ` +
+		`'<div id=injected style=width:20in>injected</div>')">`
+
+	testCases := []struct {
+		name      string
+		options   []chrome.Option
+		overflows int
+	}{
+		{
+			name:      "with-javascript",
+			overflows: 1,
+		},
+		{
+			name:    "without-javascript",
+			options: []chrome.Option{chrome.WithoutJavaScript()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				engine := chrome.NewHeadless(tc.options...)
+				defer engine.Close()
+
+				_, report, err := engine.GenerateWithReport(t.Context(), []byte(content))
+
+				require.NoError(t, err)
+				require.Len(t, report.Overflows, tc.overflows)
+			},
+		)
+	}
+}
@@ -0,0 +1,135 @@
+package chrome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/pdfdoc"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+const (
+	// minPrintScale is the minimum scale accepted by the browser when printing.
+	minPrintScale = 0.1
+
+	// fitScalePrecision is the precision of the scale found to fit the content in the pages.
+	fitScalePrecision = 0.01
+)
+
+var (
+	ErrPageOverflow    = errors.New("content does not fit in the maximum number of pages")
+	ErrInvalidMaxPages = errors.New("maximum number of pages cannot be negative")
+	ErrInvalidMinScale = fmt.Errorf("minimum scale must be between %g and 1", minPrintScale)
+)
+
+// WithMaxPages scales the content down until the PDF fits in the maximum number of pages.
+// Generating the PDF fails with ErrPageOverflow if the content does not fit at the minimum
+// scale. A zero minimum scale uses types.DefaultPageMinScale.
+func WithMaxPages(maxPages int, minScale float64) Option {
+	return func(o *options) {
+		o.maxPages = maxPages
+		o.minScale = minScale
+	}
+}
+
+// validateFit checks the maximum number of pages and the minimum scale.
+func (o options) validateFit() error {
+	if o.maxPages < 0 {
+		return ErrInvalidMaxPages
+	}
+
+	if o.minScale != 0 && (o.minScale < minPrintScale || o.minScale > 1) {
+		return ErrInvalidMinScale
+	}
+
+	return nil
+}
+
+// getFitToPagesAction prints the PDF at the largest scale, between the minimum scale and 1,
+// at which the content fits in the maximum number of pages. The scale is kept for the next
+// prints of the same content (e.g. the first page without the header).
+func (h *Headless) getFitToPagesAction(
+	params printParams,
+	scale *float64,
+	output *[]byte,
+) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		printAt := func(printScale float64) ([]byte, int, error) {
+			var pdf []byte
+
+			*scale = printScale
+
+			if err := h.getPrintToPDFAction(params, scale, &pdf)(ctx); err != nil {
+				return nil, 0, err
+			}
+
+			pages, err := countPages(pdf)
+
+			return pdf, pages, err
+		}
+
+		pdf, pages, err := printAt(1)
+		if err != nil {
+			return err
+		}
+
+		if pages <= h.config.maxPages {
+			*output = pdf
+
+			return nil
+		}
+
+		minScale := h.config.minScale
+		if minScale == 0 {
+			minScale = types.DefaultPageMinScale
+		}
+
+		fitted, pages, err := printAt(minScale)
+		if err != nil {
+			return err
+		}
+
+		if pages > h.config.maxPages {
+			return fmt.Errorf(
+				"%w: %d pages at the minimum scale %g, which is %d page(s) more than %d",
+				ErrPageOverflow, pages, minScale, pages-h.config.maxPages, h.config.maxPages,
+			)
+		}
+
+		fittedScale := minScale
+
+		for low, high := minScale, 1.0; high-low > fitScalePrecision; {
+			middle := (low + high) / 2 //nolint:mnd
+
+			pdf, pages, err = printAt(middle)
+			if err != nil {
+				return err
+			}
+
+			if pages <= h.config.maxPages {
+				low, fitted, fittedScale = middle, pdf, middle
+			} else {
+				high = middle
+			}
+		}
+
+		slog.Debug("Scaled the content to fit the pages", "scale", fittedScale, "maxPages", h.config.maxPages)
+
+		*scale = fittedScale
+		*output = fitted
+
+		return nil
+	}
+}
+
+// countPages returns the number of pages of the printed PDF.
+func countPages(pdf []byte) (int, error) {
+	doc, err := pdfdoc.Parse(pdf)
+	if err != nil {
+		return 0, err
+	}
+
+	return doc.PageCount()
+}
@@ -0,0 +1,126 @@
+package chrome
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/pdfdoc"
+)
+
+// minHeaderFooterMargin is the margin in inch needed for a single line of the header or
+// the footer, which are printed within the top and the bottom margins of the page.
+const minHeaderFooterMargin = 0.3
+
+// emptyHeaderFooter replaces the missing header or footer, as the browser prints its own
+// (e.g. the date and the title) if it is not provided.
+const emptyHeaderFooter = "<span></span>"
+
+// headerFooter is a rendered header or footer template.
+type headerFooter struct {
+	template      string
+	skipFirstPage bool
+}
+
+// printParams are the parameters that vary between the prints of the same content.
+type printParams struct {
+	header     string
+	footer     string
+	pageRanges string
+}
+
+// WithHeaderTemplate prints the rendered HTML snippet at the top of the pages. The elements
+// having the pageNumber and totalPages classes are filled with the page number and the
+// number of pages.
+func WithHeaderTemplate(template string, skipFirstPage bool) Option {
+	return func(o *options) {
+		o.header = &headerFooter{template: template, skipFirstPage: skipFirstPage}
+	}
+}
+
+// WithFooterTemplate prints the rendered HTML snippet at the bottom of the pages. The elements
+// having the pageNumber and totalPages classes are filled with the page number and the
+// number of pages.
+func WithFooterTemplate(template string, skipFirstPage bool) Option {
+	return func(o *options) {
+		o.footer = &headerFooter{template: template, skipFirstPage: skipFirstPage}
+	}
+}
+
+// validateHeaderFooterMargin checks that the margins leave room for the header and the footer.
+func (o options) validateHeaderFooterMargin() error {
+	if o.header != nil && o.pageMargin.Top < minHeaderFooterMargin {
+		return fmt.Errorf("top margin must be at least %gin to print the header", minHeaderFooterMargin)
+	}
+
+	if o.footer != nil && o.pageMargin.Bottom < minHeaderFooterMargin {
+		return fmt.Errorf("bottom margin must be at least %gin to print the footer", minHeaderFooterMargin)
+	}
+
+	return nil
+}
+
+// printParams returns the parameters of printing all the pages and, if the header or the footer
+// is skipped on the first page, the parameters of printing the first page without them.
+func (o options) printParams() (printParams, *printParams) {
+	all := printParams{
+		header: o.wrapHeaderFooter(o.header),
+		footer: o.wrapHeaderFooter(o.footer),
+	}
+
+	if (o.header == nil || !o.header.skipFirstPage) && (o.footer == nil || !o.footer.skipFirstPage) {
+		return all, nil
+	}
+
+	first := all
+	first.pageRanges = "1"
+
+	if o.header != nil && o.header.skipFirstPage {
+		first.header = emptyHeaderFooter
+	}
+
+	if o.footer != nil && o.footer.skipFirstPage {
+		first.footer = emptyHeaderFooter
+	}
+
+	return all, &first
+}
+
+// wrapHeaderFooter wraps the snippet to be aligned with the content of the page. The header
+// and the footer have a tiny font size and no background by default in the browser.
+func (o options) wrapHeaderFooter(element *headerFooter) string {
+	if element == nil {
+		return emptyHeaderFooter
+	}
+
+	return fmt.Sprintf(
+		`<div style="font-size: 10px; width: 100%%; box-sizing: border-box; padding: 0 %gin 0 %gin; `+
+			`-webkit-print-color-adjust: exact;">%s</div>`,
+		o.pageMargin.Right, o.pageMargin.Left, element.template,
+	)
+}
+
+// hasHeaderFooter reports whether the header or the footer is printed.
+func (o options) hasHeaderFooter() bool {
+	return o.header != nil || o.footer != nil
+}
+
+// replaceFirstPage returns the PDF with its first page printed without the skipped header
+// or footer. The PDF is kept as printed if the pages cannot be combined.
+func replaceFirstPage(pdf, firstPage []byte) []byte {
+	doc, err := pdfdoc.Parse(pdf)
+	if err == nil {
+		var source *pdfdoc.Document
+
+		if source, err = pdfdoc.Parse(firstPage); err == nil {
+			err = doc.ReplaceFirstPageContent(source)
+		}
+	}
+
+	if err != nil {
+		slog.Warn("Failed to skip the header and footer on the first page", "error", err)
+
+		return pdf
+	}
+
+	return doc.Bytes()
+}
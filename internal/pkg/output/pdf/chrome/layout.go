@@ -0,0 +1,121 @@
+package chrome
+
+import (
+	"context"
+	"math"
+
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/chromedp"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+// maxReportedOverflows limits the overflowing elements in the report, as a single wide
+// element usually makes many of its descendants overflow too.
+const maxReportedOverflows = 10
+
+// layoutScript measures the sections and the elements overflowing the printable width in
+// the print layout. Only the outermost overflowing elements are reported.
+const layoutScript = `(() => {
+	const width = document.documentElement.clientWidth;
+	const describe = (element) => element.tagName.toLowerCase() +
+		(element.id ? '#' + element.id : '') +
+		(typeof element.className === 'string' && element.className.trim()
+			? '.' + element.className.trim().split(/\s+/).join('.') : '');
+
+	const sections = Array.from(document.querySelectorAll('section')).map((section, i) => {
+		const rect = section.getBoundingClientRect();
+		const heading = section.querySelector('h1, h2, h3, h4');
+
+		return {
+			name: section.dataset.section || section.id ||
+				(heading && heading.textContent.trim()) || 'section ' + (i + 1),
+			top: rect.top + window.scrollY,
+			bottom: rect.bottom + window.scrollY,
+		};
+	});
+
+	const overflows = [];
+
+	for (const element of document.body.querySelectorAll('*')) {
+		const right = element.getBoundingClientRect().right;
+		const parent = element.parentElement;
+
+		if (right > width + 1 && (!parent || parent === document.body ||
+			parent.getBoundingClientRect().right <= width + 1)) {
+			overflows.push({element: describe(element), overflow: right - width});
+		}
+	}
+
+	return {sections, overflows};
+})()`
+
+type measuredLayout struct {
+	Sections []struct {
+		Name   string  `json:"name"`
+		Top    float64 `json:"top"`
+		Bottom float64 `json:"bottom"`
+	} `json:"sections"`
+	Overflows []struct {
+		Element  string  `json:"element"`
+		Overflow float64 `json:"overflow"`
+	} `json:"overflows"`
+}
+
+// getMeasureLayoutAction lays the content out in the printable area of the page at the print
+// scale, and reports the sections breaking across pages and the overflowing elements.
+// The pages of the sections are estimated from their position, as the forced page breaks
+// of the print layout are not known.
+func (h *Headless) getMeasureLayoutAction(scale *float64, report *types.LayoutReport) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		width, height := h.config.pageLayout.Dimensions()
+		margin := h.config.pageMargin
+
+		// At a lower scale, more of the content fits in the printable area of the page.
+		printableWidth := float64(width-margin.Left-margin.Right) * cssPixelsPerInch / *scale
+		printableHeight := float64(height-margin.Top-margin.Bottom) * cssPixelsPerInch / *scale
+
+		var measured measuredLayout
+
+		err := chromedp.Tasks{
+			emulation.SetDeviceMetricsOverride(
+				int64(math.Round(printableWidth)), int64(math.Round(printableHeight)), 1, false,
+			),
+			emulation.SetEmulatedMedia().WithMedia("print"),
+			chromedp.Evaluate(layoutScript, &measured),
+			emulation.ClearDeviceMetricsOverride(),
+			emulation.SetEmulatedMedia(),
+		}.Do(ctx)
+		if err != nil {
+			return err
+		}
+
+		report.Scale = *scale
+
+		for _, section := range measured.Sections {
+			firstPage := pageOf(section.Top, printableHeight)
+			lastPage := pageOf(math.Max(section.Top, section.Bottom-1), printableHeight)
+
+			if lastPage > firstPage {
+				report.SplitSections = append(report.SplitSections, types.SplitSection{
+					Name:      section.Name,
+					FirstPage: firstPage,
+					LastPage:  lastPage,
+				})
+			}
+		}
+
+		for _, overflow := range measured.Overflows[:min(len(measured.Overflows), maxReportedOverflows)] {
+			report.Overflows = append(report.Overflows, types.LayoutOverflow{
+				Element:  overflow.Element,
+				Overflow: types.Length(overflow.Overflow * *scale / cssPixelsPerInch),
+			})
+		}
+
+		return nil
+	}
+}
+
+// pageOf returns the number of the page, starting from 1, having the position of the content.
+func pageOf(position float64, pageHeight float64) int {
+	return int(math.Max(position, 0)/pageHeight) + 1
+}
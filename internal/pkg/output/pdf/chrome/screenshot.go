@@ -0,0 +1,194 @@
+package chrome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/chromedp"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+const (
+	// cssPixelsPerInch is the number of CSS pixels in an inch, which is the DPI of
+	// the screenshots when the device scale factor is 1.
+	cssPixelsPerInch = 96
+	maxDPI           = 600
+	imageQuality     = 90
+)
+
+var (
+	ErrInvalidDPI         = fmt.Errorf("DPI must be between 1 and %d", maxDPI)
+	ErrInvalidImageFormat = errors.New("image format is not supported")
+)
+
+// Screenshot renders the content in the headless browser with the width of the page size
+// and captures it as images.
+// The page margins are not applied, as they are only part of the print layout.
+// Like Headless, it reuses its browser between the renders until it is closed.
+type Screenshot struct {
+	browserOwner
+
+	config options
+}
+
+var (
+	_ types.OutputGenerator      = &Screenshot{}
+	_ types.PagedOutputGenerator = &Screenshot{}
+)
+
+// WithDPI sets the resolution of the images. Default is types.DefaultImageDPI.
+func WithDPI(dpi int) Option {
+	return func(o *options) {
+		o.dpi = dpi
+	}
+}
+
+// WithImageFormat sets the format of the images. Valid formats are png, jpg and webp.
+// Default is png.
+func WithImageFormat(format types.OutputType) Option {
+	return func(o *options) {
+		o.imageFormat = format
+	}
+}
+
+func NewScreenshot(opts ...Option) *Screenshot {
+	instanceOpts := options{
+		pageLayout:  types.PageLayout{Size: types.DefaultPageSize},
+		dpi:         types.DefaultImageDPI,
+		imageFormat: types.OutputTypePng,
+	}
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	return &Screenshot{
+		config: instanceOpts,
+	}
+}
+
+// Generate captures the whole content as a single image.
+func (s *Screenshot) Generate(ctx context.Context, content []byte) ([]byte, error) {
+	images, err := s.capture(ctx, content, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return images[0], nil
+}
+
+// GeneratePages captures the content as one image per page. The content is split
+// at the height of the page size.
+func (s *Screenshot) GeneratePages(ctx context.Context, content []byte) ([][]byte, error) {
+	return s.capture(ctx, content, true)
+}
+
+func (s *Screenshot) capture(ctx context.Context, content []byte, split bool) ([][]byte, error) {
+	if err := s.config.pageLayout.Validate(); err != nil {
+		return nil, err
+	}
+
+	if s.config.dpi < 1 || s.config.dpi > maxDPI {
+		return nil, ErrInvalidDPI
+	}
+
+	format, err := screenshotFormat(s.config.imageFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	widthInch, heightInch := s.config.pageLayout.Dimensions()
+	width := math.Round(float64(widthInch) * cssPixelsPerInch)
+	pageHeight := math.Round(float64(heightInch) * cssPixelsPerInch)
+
+	var images [][]byte
+
+	captureTask := append(
+		chromedp.Tasks{
+			emulation.SetDeviceMetricsOverride(
+				int64(width),
+				int64(pageHeight),
+				float64(s.config.dpi)/cssPixelsPerInch,
+				false,
+			),
+			// Templates style the printed documents using the print media queries.
+			emulation.SetEmulatedMedia().WithMedia("print"),
+		},
+		append(
+			loadTasks(content, s.config.noScripts),
+			chromedp.ActionFunc(
+				func(ctx context.Context) error {
+					var contentHeight float64
+
+					err := chromedp.Evaluate(`document.documentElement.scrollHeight`, &contentHeight).Do(ctx)
+					if err != nil {
+						return err
+					}
+
+					for _, clip := range pageClips(width, pageHeight, contentHeight, split) {
+						params := page.CaptureScreenshot().
+							WithFormat(format).
+							WithClip(clip).
+							WithCaptureBeyondViewport(true)
+
+						// The quality only applies to the lossy formats.
+						if format != page.CaptureScreenshotFormatPng {
+							params = params.WithQuality(imageQuality)
+						}
+
+						image, err := params.Do(ctx)
+						if err != nil {
+							return err
+						}
+
+						images = append(images, image)
+					}
+
+					return nil
+				},
+			),
+		)...,
+	)
+
+	if err = s.run(ctx, s.config, captureTask); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// pageClips returns the areas of the content to capture. The content is captured as a whole,
+// or split into the areas having the page height. The content is at least one page high.
+func pageClips(width float64, pageHeight float64, contentHeight float64, split bool) []*page.Viewport {
+	contentHeight = max(contentHeight, pageHeight)
+
+	if !split {
+		return []*page.Viewport{{Width: width, Height: contentHeight, Scale: 1}}
+	}
+
+	pages := int(math.Ceil(contentHeight / pageHeight))
+	clips := make([]*page.Viewport, 0, pages)
+
+	for i := range pages {
+		clips = append(clips, &page.Viewport{Y: float64(i) * pageHeight, Width: width, Height: pageHeight, Scale: 1})
+	}
+
+	return clips
+}
+
+func screenshotFormat(format types.OutputType) (page.CaptureScreenshotFormat, error) {
+	switch format {
+	case types.OutputTypePng:
+		return page.CaptureScreenshotFormatPng, nil
+	case types.OutputTypeJpg:
+		return page.CaptureScreenshotFormatJpeg, nil
+	case types.OutputTypeWebp:
+		return page.CaptureScreenshotFormatWebp, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidImageFormat, format)
+	}
+}
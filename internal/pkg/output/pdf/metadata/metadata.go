@@ -0,0 +1,111 @@
+// Package metadata writes the document information dictionary and the XMP metadata of
+// the PDF documents printed by the headless browser.
+package metadata
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/pdfdoc"
+)
+
+// Info is the metadata of the document.
+type Info struct {
+	Title    string
+	Author   string
+	Subject  string
+	Keywords []string
+	Creator  string
+
+	// CreationDate is the creation and modification date of the document.
+	// The current time is used if it is zero.
+	CreationDate time.Time
+}
+
+// Write returns the document with the info dictionary replaced by the provided info, and
+// the XMP metadata stream added to its catalog. The document is rewritten rather than
+// updated incrementally, so the output only depends on the document and the info.
+func Write(pdf []byte, info Info) ([]byte, error) {
+	doc, err := pdfdoc.Parse(pdf)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.CreationDate.IsZero() {
+		info.CreationDate = time.Now()
+	}
+
+	if doc.Info() == 0 {
+		doc.SetInfo(doc.AddObject(nil))
+	}
+
+	doc.SetObject(doc.Info(), []byte(info.dictionary()))
+
+	xmp := info.xmp()
+	metadataNumber := doc.AddObject(
+		fmt.Appendf(nil, "<< /Type /Metadata /Subtype /XML /Length %d >>\nstream\n%s\nendstream", len(xmp), xmp),
+	)
+
+	root, _ := doc.Object(doc.Root())
+
+	root, ok := pdfdoc.SetDictValue(root, "Metadata", fmt.Appendf(nil, "%d 0 R", metadataNumber))
+	if !ok {
+		return nil, fmt.Errorf("%w: root is not a dictionary", pdfdoc.ErrUnsupportedPDF)
+	}
+
+	doc.SetObject(doc.Root(), root)
+
+	return doc.Bytes(), nil
+}
+
+// dictionary returns the document information dictionary.
+func (i Info) dictionary() string {
+	entries := []string{"<<"}
+
+	for _, entry := range []struct{ key, value string }{
+		{"Title", i.Title},
+		{"Author", i.Author},
+		{"Subject", i.Subject},
+		{"Keywords", strings.Join(i.Keywords, ", ")},
+		{"Creator", i.Creator},
+	} {
+		if entry.value != "" {
+			entries = append(entries, "/"+entry.key+" "+textString(entry.value))
+		}
+	}
+
+	date := "(" + i.CreationDate.UTC().Format("D:20060102150405Z") + ")"
+
+	entries = append(entries, "/CreationDate "+date, "/ModDate "+date, ">>")
+
+	return strings.Join(entries, " ")
+}
+
+// textString encodes the text as a literal string if it is printable ASCII,
+// or as a hexadecimal UTF-16BE string otherwise.
+func textString(text string) string {
+	ascii := true
+
+	for _, r := range text {
+		if r < ' ' || r > '~' {
+			ascii = false
+
+			break
+		}
+	}
+
+	if ascii {
+		return "(" + strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(text) + ")"
+	}
+
+	encoded := []byte{0xFE, 0xFF}
+
+	for _, unit := range utf16.Encode([]rune(text)) {
+		encoded = append(encoded, byte(unit>>8), byte(unit)) //nolint:mnd
+	}
+
+	return "<" + hex.EncodeToString(encoded) + ">"
+}
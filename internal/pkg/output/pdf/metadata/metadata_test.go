@@ -0,0 +1,155 @@
+package metadata_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/metadata"
+	"github.com/seinshah/civic/internal/pkg/output/pdf/pdfdoc"
+	"github.com/stretchr/testify/require"
+)
+
+// buildPDF builds a document having the objects and a classic cross-reference table.
+func buildPDF(t *testing.T, trailer string, objects ...string) []byte {
+	t.Helper()
+
+	var builder strings.Builder
+
+	builder.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	offsets := make([]int, 0, len(objects))
+
+	for i, obj := range objects {
+		offsets = append(offsets, builder.Len())
+		fmt.Fprintf(&builder, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xrefOffset := builder.Len()
+
+	fmt.Fprintf(&builder, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&builder, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&builder, "trailer\n%s\nstartxref\n%d\n%%%%EOF\n", trailer, xrefOffset)
+
+	return []byte(builder.String())
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	catalog := "<< /Type /Catalog /Pages 2 0 R >>"
+	pages := "<< /Type /Pages /Kids [3 0 R] /Count 1 >>"
+	page := "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] >>"
+	info := "<< /Producer (Skia/PDF m120) /CreationDate (D:20240101120000+00'00') >>"
+
+	creationDate := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		pdf         []byte
+		info        metadata.Info
+		contains    []string
+		notContains []string
+		err         error
+	}{
+		{
+			name: "replaced info",
+			pdf:  buildPDF(t, "<< /Size 5 /Root 1 0 R /Info 4 0 R >>", catalog, pages, page, info),
+			info: metadata.Info{
+				Title:        "Jane Doe (CV)",
+				Author:       "Jane Doe",
+				Subject:      "Software Engineer",
+				Keywords:     []string{"Go", "Kubernetes"},
+				Creator:      "civic v1.0.0",
+				CreationDate: creationDate,
+			},
+			contains: []string{
+				"1 0 obj\n<< /Metadata 5 0 R /Type /Catalog /Pages 2 0 R >>",
+				`4 0 obj
+<< /Title (Jane Doe \(CV\)) /Author (Jane Doe) /Subject (Software Engineer) /Keywords (Go, Kubernetes) ` +
+					`/Creator (civic v1.0.0) /CreationDate (D:20250304050607Z) /ModDate (D:20250304050607Z) >>`,
+				"5 0 obj\n<< /Type /Metadata /Subtype /XML",
+				`<rdf:li xml:lang="x-default">Jane Doe (CV)</rdf:li>`,
+				"<rdf:Bag><rdf:li>Go</rdf:li><rdf:li>Kubernetes</rdf:li></rdf:Bag>",
+				"<xmp:CreateDate>2025-03-04T05:06:07Z</xmp:CreateDate>",
+				"/Size 6 /Root 1 0 R /Info 4 0 R /ID [<",
+			},
+			notContains: []string{"Skia/PDF"},
+		},
+		{
+			name: "missing info",
+			pdf:  buildPDF(t, "<< /Size 4 /Root 1 0 R >>", catalog, pages, page),
+			info: metadata.Info{Title: "José Núñez", CreationDate: creationDate},
+			contains: []string{
+				"4 0 obj\n<< /Title <feff004a006f007300e90020004e00fa00f10065007a>",
+				"5 0 obj\n<< /Type /Metadata",
+				"/Size 6 /Root 1 0 R /Info 4 0 R",
+			},
+		},
+		{
+			name: "replaced metadata reference",
+			pdf: buildPDF(
+				t, "<< /Size 5 /Root 1 0 R >>",
+				"<< /Type /Catalog /Pages 2 0 R /Metadata 4 0 R >>", pages, page, "<< /Length 0 >>\nstream\n\nendstream",
+			),
+			info:        metadata.Info{CreationDate: creationDate},
+			contains:    []string{"<< /Type /Catalog /Pages 2 0 R /Metadata 6 0 R >>", "/Size 7 /Root 1 0 R /Info 5 0 R"},
+			notContains: []string{"/Metadata 4 0 R"},
+		},
+		{
+			name: "updated document",
+			pdf:  buildPDF(t, "<< /Size 4 /Root 1 0 R /Prev 10 >>", catalog, pages, page),
+			err:  pdfdoc.ErrUnsupportedPDF,
+		},
+		{
+			name: "cross-reference stream",
+			pdf:  []byte("%PDF-1.5\n1 0 obj\n<< /Type /XRef >>\nstream\nendstream\nendobj\nstartxref\n9\n%%EOF\n"),
+			err:  pdfdoc.ErrUnsupportedPDF,
+		},
+		{
+			name: "not a document",
+			pdf:  []byte("<html></html>"),
+			err:  pdfdoc.ErrUnsupportedPDF,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				output, err := metadata.Write(tc.pdf, tc.info)
+
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+					require.Nil(t, output)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				for _, text := range tc.contains {
+					require.Contains(t, string(output), text)
+				}
+
+				for _, text := range tc.notContains {
+					require.NotContains(t, string(output), text)
+				}
+
+				// The output is deterministic, and its cross-reference table is valid.
+				again, err := metadata.Write(tc.pdf, tc.info)
+				require.NoError(t, err)
+				require.Equal(t, output, again)
+
+				_, err = metadata.Write(output, tc.info)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package metadata
+
+import (
+	"strings"
+	"time"
+)
+
+// xmp returns the XMP metadata packet of the document, which mirrors the information dictionary.
+func (i Info) xmp() string {
+	var builder strings.Builder
+
+	date := i.CreationDate.UTC().Format(time.RFC3339)
+
+	builder.WriteString(`<?xpacket begin="` + "\uFEFF" + `" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+<rdf:Description rdf:about=""
+  xmlns:dc="http://purl.org/dc/elements/1.1/"
+  xmlns:pdf="http://ns.adobe.com/pdf/1.3/"
+  xmlns:xmp="http://ns.adobe.com/xap/1.0/">
+<dc:format>application/pdf</dc:format>
+`)
+
+	if i.Title != "" {
+		builder.WriteString(`<dc:title><rdf:Alt><rdf:li xml:lang="x-default">` + escapeXML(i.Title) +
+			"</rdf:li></rdf:Alt></dc:title>\n")
+	}
+
+	if i.Author != "" {
+		builder.WriteString("<dc:creator><rdf:Seq><rdf:li>" + escapeXML(i.Author) + "</rdf:li></rdf:Seq></dc:creator>\n")
+	}
+
+	if i.Subject != "" {
+		builder.WriteString(`<dc:description><rdf:Alt><rdf:li xml:lang="x-default">` + escapeXML(i.Subject) +
+			"</rdf:li></rdf:Alt></dc:description>\n")
+	}
+
+	if len(i.Keywords) > 0 {
+		builder.WriteString("<dc:subject><rdf:Bag>")
+
+		for _, keyword := range i.Keywords {
+			builder.WriteString("<rdf:li>" + escapeXML(keyword) + "</rdf:li>")
+		}
+
+		builder.WriteString("</rdf:Bag></dc:subject>\n")
+		builder.WriteString("<pdf:Keywords>" + escapeXML(strings.Join(i.Keywords, ", ")) + "</pdf:Keywords>\n")
+	}
+
+	if i.Creator != "" {
+		builder.WriteString("<xmp:CreatorTool>" + escapeXML(i.Creator) + "</xmp:CreatorTool>\n")
+	}
+
+	builder.WriteString("<xmp:CreateDate>" + date + "</xmp:CreateDate>\n")
+	builder.WriteString("<xmp:ModifyDate>" + date + "</xmp:ModifyDate>\n")
+	builder.WriteString("<xmp:MetadataDate>" + date + "</xmp:MetadataDate>\n")
+	builder.WriteString(`</rdf:Description>
+</rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`)
+
+	return builder.String()
+}
+
+//nolint:gochecknoglobals
+var xmlEscaper = strings.NewReplacer(`&`, "&amp;", `<`, "&lt;", `>`, "&gt;", `"`, "&quot;", `'`, "&apos;")
+
+func escapeXML(text string) string {
+	return xmlEscaper.Replace(text)
+}
@@ -0,0 +1,31 @@
+package pdfdoc
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// PageCount returns the number of pages of the document, as recorded in its page tree.
+func (d *Document) PageCount() (int, error) {
+	value, ok := DictValue(d.objects[d.root], "Pages")
+	if !ok {
+		return 0, fmt.Errorf("%w: root has no page tree", ErrUnsupportedPDF)
+	}
+
+	number, ok := Reference(value)
+	if !ok {
+		return 0, fmt.Errorf("%w: invalid page tree", ErrUnsupportedPDF)
+	}
+
+	count, ok := DictValue(d.objects[number], "Count")
+	if !ok {
+		return 0, fmt.Errorf("%w: page tree has no count", ErrUnsupportedPDF)
+	}
+
+	pages, err := strconv.Atoi(string(count))
+	if err != nil || pages < 0 {
+		return 0, fmt.Errorf("%w: invalid page count %q", ErrUnsupportedPDF, count)
+	}
+
+	return pages, nil
+}
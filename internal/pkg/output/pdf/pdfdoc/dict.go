@@ -0,0 +1,222 @@
+package pdfdoc
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+//nolint:gochecknoglobals
+var (
+	referencePattern = regexp.MustCompile(`(\d+)\s+(\d+)\s+R\b`)
+	streamPattern    = regexp.MustCompile(`>>\s*stream\r?\n`)
+)
+
+// DictValue returns the value of the key in the dictionary of the object body. The nested
+// dictionaries are not searched.
+func DictValue(body []byte, key string) ([]byte, bool) {
+	start, end, ok := findDictValue(body, key)
+	if !ok {
+		return nil, false
+	}
+
+	return body[start:end], true
+}
+
+// SetDictValue returns the object body with the value of the key replaced, or with the key
+// added to the dictionary if it does not exist. ok is false if the body is not a dictionary.
+func SetDictValue(body []byte, key string, value []byte) ([]byte, bool) {
+	if start, end, ok := findDictValue(body, key); ok {
+		return slices.Concat(body[:start], value, body[end:]), true
+	}
+
+	index := bytes.Index(body, []byte("<<"))
+	if index < 0 {
+		return nil, false
+	}
+
+	index += len("<<")
+
+	return slices.Concat(body[:index], []byte(" /"+key+" "), value, body[index:]), true
+}
+
+// References returns the numbers of the objects referred to by the value, or by the
+// dictionary of the object body. The stream data is not searched.
+func References(value []byte) []int {
+	var numbers []int
+
+	for _, match := range referencePattern.FindAllSubmatch(dictPart(value), -1) {
+		number, _ := strconv.Atoi(string(match[1]))
+		numbers = append(numbers, number)
+	}
+
+	return numbers
+}
+
+// Reference returns the number of the object referred to by the value, if it is a reference.
+func Reference(value []byte) (int, bool) {
+	match := referencePattern.FindSubmatch(bytes.TrimSpace(value))
+	if match == nil || len(match[0]) != len(bytes.TrimSpace(value)) {
+		return 0, false
+	}
+
+	number, _ := strconv.Atoi(string(match[1]))
+
+	return number, true
+}
+
+// renumber replaces the references in the value, or in the dictionary of the object body,
+// using the mapping. The references missing in the mapping are kept as is.
+func renumber(value []byte, mapping map[int]int) []byte {
+	dict := dictPart(value)
+
+	renumbered := referencePattern.ReplaceAllFunc(dict, func(reference []byte) []byte {
+		match := referencePattern.FindSubmatch(reference)
+		number, _ := strconv.Atoi(string(match[1]))
+
+		if newNumber, ok := mapping[number]; ok {
+			return []byte(strconv.Itoa(newNumber) + " 0 R")
+		}
+
+		return reference
+	})
+
+	return slices.Concat(renumbered, value[len(dict):])
+}
+
+// dictPart returns the part of the object body before its stream data, if it is a stream.
+func dictPart(body []byte) []byte {
+	if loc := streamPattern.FindIndex(body); loc != nil {
+		return body[:loc[0]+len(">>")]
+	}
+
+	return body
+}
+
+// findDictValue returns the position of the value of the key in the dictionary of the body.
+func findDictValue(body []byte, key string) (int, int, bool) {
+	i := skipSpace(body, 0)
+	if !bytes.HasPrefix(body[i:], []byte("<<")) {
+		return 0, 0, false
+	}
+
+	i += len("<<")
+
+	for {
+		i = skipSpace(body, i)
+
+		if i >= len(body) || bytes.HasPrefix(body[i:], []byte(">>")) || body[i] != '/' {
+			return 0, 0, false
+		}
+
+		nameEnd := valueEnd(body, i)
+		valueStart := skipSpace(body, nameEnd)
+		end := valueEnd(body, valueStart)
+
+		if string(body[i+1:nameEnd]) == key {
+			return valueStart, end, true
+		}
+
+		i = end
+	}
+}
+
+// valueEnd returns the position right after the value starting at the position.
+func valueEnd(body []byte, i int) int {
+	if i >= len(body) {
+		return len(body)
+	}
+
+	switch {
+	case bytes.HasPrefix(body[i:], []byte("<<")):
+		return containerEnd(body, i+len("<<"), ">>")
+	case body[i] == '[':
+		return containerEnd(body, i+1, "]")
+	case body[i] == '(':
+		return stringEnd(body, i)
+	case body[i] == '<':
+		if end := bytes.IndexByte(body[i:], '>'); end >= 0 {
+			return i + end + 1
+		}
+
+		return len(body)
+	case body[i] == '/':
+		return tokenEnd(body, i+1)
+	}
+
+	if loc := referencePattern.FindIndex(body[i:]); loc != nil && loc[0] == 0 {
+		return i + loc[1]
+	}
+
+	return max(tokenEnd(body, i), i+1)
+}
+
+// containerEnd returns the position right after the closing delimiter of the dictionary or
+// the array whose content starts at the position.
+func containerEnd(body []byte, i int, closing string) int {
+	for {
+		i = skipSpace(body, i)
+
+		if i >= len(body) {
+			return len(body)
+		}
+
+		if bytes.HasPrefix(body[i:], []byte(closing)) {
+			return i + len(closing)
+		}
+
+		i = valueEnd(body, i)
+	}
+}
+
+// stringEnd returns the position right after the literal string starting at the position.
+func stringEnd(body []byte, i int) int {
+	depth := 0
+
+	for ; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return len(body)
+}
+
+func tokenEnd(body []byte, i int) int {
+	for i < len(body) && !isSpace(body[i]) && !bytes.ContainsRune([]byte("()<>[]{}/%"), rune(body[i])) {
+		i++
+	}
+
+	return i
+}
+
+func skipSpace(body []byte, i int) int {
+	for i < len(body) {
+		switch {
+		case isSpace(body[i]):
+			i++
+		case body[i] == '%':
+			for i < len(body) && body[i] != '\n' && body[i] != '\r' {
+				i++
+			}
+		default:
+			return i
+		}
+	}
+
+	return i
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
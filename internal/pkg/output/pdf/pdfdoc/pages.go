@@ -0,0 +1,130 @@
+package pdfdoc
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+)
+
+// FirstPage returns the number of the first page object of the page tree.
+func (d *Document) FirstPage() (int, error) {
+	value, ok := DictValue(d.objects[d.root], "Pages")
+	if !ok {
+		return 0, fmt.Errorf("%w: root has no page tree", ErrUnsupportedPDF)
+	}
+
+	// The depth of the page tree cannot exceed the number of objects.
+	for range len(d.objects) {
+		number, ok := Reference(value)
+		if !ok {
+			return 0, fmt.Errorf("%w: invalid page tree", ErrUnsupportedPDF)
+		}
+
+		body, ok := d.objects[number]
+		if !ok {
+			return 0, fmt.Errorf("%w: page %d is not found", ErrUnsupportedPDF, number)
+		}
+
+		if objectType, _ := DictValue(body, "Type"); string(objectType) == "/Page" {
+			return number, nil
+		}
+
+		kids, ok := DictValue(body, "Kids")
+		if !ok || len(References(kids)) == 0 {
+			return 0, fmt.Errorf("%w: page tree has no pages", ErrUnsupportedPDF)
+		}
+
+		value = fmt.Appendf(nil, "%d 0 R", References(kids)[0])
+	}
+
+	return 0, fmt.Errorf("%w: page tree has a cycle", ErrUnsupportedPDF)
+}
+
+// ReplaceFirstPageContent replaces the content and the resources of the first page with those
+// of the first page of the source document, which is expected to have the same layout.
+// The objects they refer to are copied from the source document, and the other properties of
+// the page (e.g. its links) are kept, so the references to the page remain valid.
+func (d *Document) ReplaceFirstPageContent(source *Document) error {
+	pageNumber, err := d.FirstPage()
+	if err != nil {
+		return err
+	}
+
+	sourcePageNumber, err := source.FirstPage()
+	if err != nil {
+		return err
+	}
+
+	sourcePage := source.objects[sourcePageNumber]
+	page := d.objects[pageNumber]
+
+	keys := []string{"Contents", "Resources"}
+	values := make([][]byte, 0, len(keys))
+
+	for _, key := range keys {
+		value, ok := DictValue(sourcePage, key)
+		if !ok {
+			return fmt.Errorf("%w: source page has no %s", ErrUnsupportedPDF, key)
+		}
+
+		values = append(values, value)
+	}
+
+	mapping := d.copyObjects(source, References(bytes.Join(values, []byte(" "))))
+
+	for i, key := range keys {
+		var ok bool
+
+		if page, ok = SetDictValue(page, key, renumber(values[i], mapping)); !ok {
+			return fmt.Errorf("%w: page is not a dictionary", ErrUnsupportedPDF)
+		}
+	}
+
+	d.objects[pageNumber] = page
+
+	return nil
+}
+
+// copyObjects copies the objects of the source document, and the objects they refer to,
+// and returns the numbers of the copies by the source numbers. The pages and the page trees
+// are not copied, as they belong to the source document.
+func (d *Document) copyObjects(source *Document, numbers []int) map[int]int {
+	var (
+		copied  []int
+		visited = make(map[int]bool)
+	)
+
+	for len(numbers) > 0 {
+		number := numbers[0]
+		numbers = numbers[1:]
+
+		body, ok := source.objects[number]
+		if !ok || visited[number] {
+			continue
+		}
+
+		visited[number] = true
+
+		if objectType, _ := DictValue(body, "Type"); string(objectType) == "/Page" ||
+			string(objectType) == "/Pages" || string(objectType) == "/Catalog" {
+			continue
+		}
+
+		copied = append(copied, number)
+		numbers = append(numbers, References(body)...)
+	}
+
+	slices.Sort(copied)
+
+	mapping := make(map[int]int, len(copied))
+
+	for i, number := range copied {
+		mapping[number] = d.size + i
+	}
+
+	for _, number := range copied {
+		d.SetObject(mapping[number], renumber(source.objects[number], mapping))
+	}
+
+	return mapping
+}
@@ -0,0 +1,259 @@
+// Package pdfdoc reads and rewrites the objects of the PDF documents printed by the headless
+// browser. It only supports the documents having a single classic cross-reference table,
+// and does not decode the object streams.
+package pdfdoc
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedPDF is returned for the documents that do not have a single classic
+// cross-reference table (e.g. the updated, encrypted, or compressed documents).
+var ErrUnsupportedPDF = errors.New("PDF document structure is not supported")
+
+//nolint:gochecknoglobals
+var (
+	sizePattern = regexp.MustCompile(`/Size\s+(\d+)`)
+	rootPattern = regexp.MustCompile(`/Root\s+(\d+)\s+\d+\s+R`)
+	infoPattern = regexp.MustCompile(`/Info\s+(\d+)\s+\d+\s+R`)
+)
+
+// Document is a parsed PDF document. The objects are kept as their raw bodies, which are
+// the bytes between the "obj" and "endobj" keywords.
+type Document struct {
+	header  []byte
+	objects map[int][]byte
+	size    int
+	root    int
+	info    int
+}
+
+// Parse parses the objects of the document using its cross-reference table.
+func Parse(pdf []byte) (*Document, error) {
+	xrefOffset, err := findXRefOffset(pdf)
+	if err != nil {
+		return nil, err
+	}
+
+	section := pdf[xrefOffset:]
+	if !bytes.HasPrefix(section, []byte("xref")) {
+		return nil, fmt.Errorf("%w: cross-reference stream", ErrUnsupportedPDF)
+	}
+
+	trailerIndex := bytes.Index(section, []byte("trailer"))
+	if trailerIndex < 0 {
+		return nil, fmt.Errorf("%w: trailer is not found", ErrUnsupportedPDF)
+	}
+
+	trailer := section[trailerIndex:]
+	if bytes.Contains(trailer, []byte("/Prev")) || bytes.Contains(trailer, []byte("/Encrypt")) {
+		return nil, fmt.Errorf("%w: updated or encrypted document", ErrUnsupportedPDF)
+	}
+
+	offsets, err := parseXRefEntries(string(section[len("xref"):trailerIndex]))
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{objects: make(map[int][]byte, len(offsets))}
+
+	rootMatch := rootPattern.FindSubmatch(trailer)
+	if rootMatch == nil {
+		return nil, fmt.Errorf("%w: trailer has no root", ErrUnsupportedPDF)
+	}
+
+	doc.root, _ = strconv.Atoi(string(rootMatch[1]))
+
+	if infoMatch := infoPattern.FindSubmatch(trailer); infoMatch != nil {
+		doc.info, _ = strconv.Atoi(string(infoMatch[1]))
+	}
+
+	if sizeMatch := sizePattern.FindSubmatch(trailer); sizeMatch != nil {
+		doc.size, _ = strconv.Atoi(string(sizeMatch[1]))
+	}
+
+	numbers := slices.SortedFunc(maps.Keys(offsets), func(a, b int) int { return offsets[a] - offsets[b] })
+
+	// The header (and the binary comment line) is kept as is.
+	doc.header = pdf[:offsets[numbers[0]]]
+
+	for i, number := range numbers {
+		end := xrefOffset
+		if i+1 < len(numbers) {
+			end = offsets[numbers[i+1]]
+		}
+
+		if offsets[number] >= end {
+			return nil, fmt.Errorf("%w: invalid object offset", ErrUnsupportedPDF)
+		}
+
+		raw := bytes.TrimRight(pdf[offsets[number]:end], " \t\r\n")
+		prefix := strconv.Itoa(number) + " 0 obj"
+
+		if !bytes.HasPrefix(raw, []byte(prefix)) || !bytes.HasSuffix(raw, []byte("endobj")) {
+			return nil, fmt.Errorf("%w: object %d is not found at its offset", ErrUnsupportedPDF, number)
+		}
+
+		doc.objects[number] = bytes.TrimSpace(raw[len(prefix) : len(raw)-len("endobj")])
+		doc.size = max(doc.size, number+1)
+	}
+
+	if _, ok := doc.objects[doc.root]; !ok {
+		return nil, fmt.Errorf("%w: root object is not found", ErrUnsupportedPDF)
+	}
+
+	return doc, nil
+}
+
+// Root returns the number of the catalog object.
+func (d *Document) Root() int {
+	return d.root
+}
+
+// Info returns the number of the document information dictionary, or 0 if there is none.
+func (d *Document) Info() int {
+	return d.info
+}
+
+// SetInfo sets the number of the document information dictionary.
+func (d *Document) SetInfo(number int) {
+	d.info = number
+}
+
+// Object returns the body of the object.
+func (d *Document) Object(number int) ([]byte, bool) {
+	body, ok := d.objects[number]
+
+	return body, ok
+}
+
+// SetObject replaces the body of the object, or adds the object if it does not exist.
+func (d *Document) SetObject(number int, body []byte) {
+	d.objects[number] = body
+	d.size = max(d.size, number+1)
+}
+
+// AddObject adds the object and returns its number.
+func (d *Document) AddObject(body []byte) int {
+	number := d.size
+	d.SetObject(number, body)
+
+	return number
+}
+
+// Bytes writes the document with a new cross-reference table. The ID of the document is
+// derived from its content, so the same objects always result in the same document.
+func (d *Document) Bytes() []byte {
+	var output bytes.Buffer
+
+	output.Write(d.header)
+
+	offsets := make(map[int]int, len(d.objects))
+
+	for _, number := range slices.Sorted(maps.Keys(d.objects)) {
+		offsets[number] = output.Len()
+
+		fmt.Fprintf(&output, "%d 0 obj\n", number)
+		output.Write(d.objects[number])
+		output.WriteString("\nendobj\n")
+	}
+
+	//nolint:gosec
+	id := md5.Sum(output.Bytes())
+	xrefOffset := output.Len()
+
+	fmt.Fprintf(&output, "xref\n0 %d\n", d.size)
+
+	for number := range d.size {
+		if offset, ok := offsets[number]; ok {
+			fmt.Fprintf(&output, "%010d 00000 n \n", offset)
+		} else {
+			output.WriteString("0000000000 65535 f \n")
+		}
+	}
+
+	trailer := fmt.Sprintf("/Size %d /Root %d 0 R", d.size, d.root)
+
+	if d.info != 0 {
+		trailer += fmt.Sprintf(" /Info %d 0 R", d.info)
+	}
+
+	fmt.Fprintf(
+		&output, "trailer\n<< %s /ID [<%x> <%x>] >>\nstartxref\n%d\n%%%%EOF\n", trailer, id, id, xrefOffset,
+	)
+
+	return output.Bytes()
+}
+
+func findXRefOffset(pdf []byte) (int, error) {
+	index := bytes.LastIndex(pdf, []byte("startxref"))
+	if index < 0 {
+		return 0, fmt.Errorf("%w: startxref is not found", ErrUnsupportedPDF)
+	}
+
+	fields := bytes.Fields(pdf[index+len("startxref"):])
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%w: startxref has no offset", ErrUnsupportedPDF)
+	}
+
+	offset, err := strconv.Atoi(string(fields[0]))
+	if err != nil || offset <= 0 || offset >= index {
+		return 0, fmt.Errorf("%w: invalid startxref offset", ErrUnsupportedPDF)
+	}
+
+	return offset, nil
+}
+
+// parseXRefEntries parses the subsections of the cross-reference table, and returns
+// the offsets of the objects in use by their number.
+func parseXRefEntries(table string) (map[int]int, error) {
+	const entryFields = 3
+
+	var (
+		offsets = make(map[int]int)
+		fields  = strings.Fields(table)
+	)
+
+	for len(fields) > 0 {
+		if len(fields) < 2 { //nolint:mnd
+			return nil, fmt.Errorf("%w: invalid cross-reference table", ErrUnsupportedPDF)
+		}
+
+		start, startErr := strconv.Atoi(fields[0])
+		count, countErr := strconv.Atoi(fields[1])
+
+		if startErr != nil || countErr != nil || start < 0 || count < 0 || len(fields) < 2+count*entryFields {
+			return nil, fmt.Errorf("%w: invalid cross-reference table", ErrUnsupportedPDF)
+		}
+
+		for i := range count {
+			entry := fields[2+i*entryFields : 2+(i+1)*entryFields]
+			if entry[2] != "n" {
+				continue
+			}
+
+			offset, err := strconv.Atoi(entry[0])
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid cross-reference entry", ErrUnsupportedPDF)
+			}
+
+			offsets[start+i] = offset
+		}
+
+		fields = fields[2+count*entryFields:]
+	}
+
+	if len(offsets) == 0 {
+		return nil, fmt.Errorf("%w: document has no objects", ErrUnsupportedPDF)
+	}
+
+	return offsets, nil
+}
@@ -0,0 +1,186 @@
+package pdfdoc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/pdf/pdfdoc"
+	"github.com/stretchr/testify/require"
+)
+
+// buildPDF builds a document having the objects and a classic cross-reference table.
+func buildPDF(t *testing.T, trailer string, objects ...string) []byte {
+	t.Helper()
+
+	var builder strings.Builder
+
+	builder.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, 0, len(objects))
+
+	for i, obj := range objects {
+		offsets = append(offsets, builder.Len())
+		fmt.Fprintf(&builder, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xrefOffset := builder.Len()
+
+	fmt.Fprintf(&builder, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&builder, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&builder, "trailer\n%s\nstartxref\n%d\n%%%%EOF\n", trailer, xrefOffset)
+
+	return []byte(builder.String())
+}
+
+func TestDictValue(t *testing.T) {
+	t.Parallel()
+
+	body := `<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 5 0 R >> >> ` +
+		`/Title (a /Contents \) (nested)) /Kids [3 0 R [4 0 R]] /Contents 6 0 R >>`
+
+	testCases := []struct {
+		name     string
+		key      string
+		expected string
+		found    bool
+	}{
+		{name: "name", key: "Type", expected: "/Page", found: true},
+		{name: "reference", key: "Parent", expected: "2 0 R", found: true},
+		{name: "dictionary", key: "Resources", expected: "<< /Font << /F1 5 0 R >> >>", found: true},
+		{name: "array", key: "Kids", expected: "[3 0 R [4 0 R]]", found: true},
+		{name: "after string", key: "Contents", expected: "6 0 R", found: true},
+		{name: "nested key", key: "Font"},
+		{name: "value name", key: "Page"},
+		{name: "missing key", key: "Annots"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				value, found := pdfdoc.DictValue([]byte(body), tc.key)
+
+				require.Equal(t, tc.found, found)
+				require.Equal(t, tc.expected, string(value))
+			},
+		)
+	}
+}
+
+func TestSetDictValue(t *testing.T) {
+	t.Parallel()
+
+	body, ok := pdfdoc.SetDictValue([]byte("<< /Type /Page /Contents 6 0 R >>"), "Contents", []byte("[7 0 R 8 0 R]"))
+	require.True(t, ok)
+	require.Equal(t, "<< /Type /Page /Contents [7 0 R 8 0 R] >>", string(body))
+
+	body, ok = pdfdoc.SetDictValue(body, "Metadata", []byte("9 0 R"))
+	require.True(t, ok)
+	require.Equal(t, "<< /Metadata 9 0 R /Type /Page /Contents [7 0 R 8 0 R] >>", string(body))
+
+	_, ok = pdfdoc.SetDictValue([]byte("[1 0 R]"), "Metadata", []byte("9 0 R"))
+	require.False(t, ok)
+}
+
+func TestDocument_ReplaceFirstPageContent(t *testing.T) {
+	t.Parallel()
+
+	target, err := pdfdoc.Parse(buildPDF(
+		t, "<< /Size 8 /Root 1 0 R >>",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 2 >>",
+		"<< /Type /Pages /Parent 2 0 R /Kids [4 0 R 5 0 R] /Count 2 >>",
+		"<< /Type /Page /Parent 3 0 R /Contents 6 0 R /Resources << /Font << /F1 7 0 R >> >> /Annots [] >>",
+		"<< /Type /Page /Parent 3 0 R /Contents 6 0 R >>",
+		"<< /Length 11 >>\nstream\nwith footer\nendstream",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	))
+	require.NoError(t, err)
+
+	source, err := pdfdoc.Parse(buildPDF(
+		t, "<< /Size 6 /Root 1 0 R >>",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents [4 0 R] /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Length 3 0 R >>\nstream\nwithout footer 1 0 R\nendstream",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Parent 2 0 R >>",
+	))
+	require.NoError(t, err)
+
+	firstPage, err := target.FirstPage()
+	require.NoError(t, err)
+	require.Equal(t, 4, firstPage)
+
+	require.NoError(t, target.ReplaceFirstPageContent(source))
+
+	page, ok := target.Object(4)
+	require.True(t, ok)
+	require.Equal(
+		t,
+		"<< /Type /Page /Parent 3 0 R /Contents [8 0 R] /Resources << /Font << /F1 9 0 R >> >> /Annots [] >>",
+		string(page),
+	)
+
+	// The page of the source document referred to by the copied objects is not copied,
+	// and the stream data is not renumbered.
+	content, ok := target.Object(8)
+	require.True(t, ok)
+	require.Equal(t, "<< /Length 3 0 R >>\nstream\nwithout footer 1 0 R\nendstream", string(content))
+
+	font, ok := target.Object(9)
+	require.True(t, ok)
+	require.Contains(t, string(font), "/Parent 2 0 R")
+
+	// The rewritten document can be parsed again.
+	reparsed, err := pdfdoc.Parse(target.Bytes())
+	require.NoError(t, err)
+
+	page, ok = reparsed.Object(4)
+	require.True(t, ok)
+	require.Contains(t, string(page), "/Contents [8 0 R]")
+}
+
+func TestDocument_PageCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		pages    string
+		expected int
+		hasError bool
+	}{
+		{name: "count", pages: "<< /Type /Pages /Kids [] /Count 3 >>", expected: 3},
+		{name: "no-count", pages: "<< /Type /Pages /Kids [] >>", hasError: true},
+		{name: "invalid-count", pages: "<< /Type /Pages /Kids [] /Count -1 >>", hasError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				doc, err := pdfdoc.Parse(buildPDF(
+					t, "<< /Size 3 /Root 1 0 R >>", "<< /Type /Catalog /Pages 2 0 R >>", tc.pages,
+				))
+				require.NoError(t, err)
+
+				count, err := doc.PageCount()
+
+				if tc.hasError {
+					require.ErrorIs(t, err, pdfdoc.ErrUnsupportedPDF)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, count)
+			},
+		)
+	}
+}
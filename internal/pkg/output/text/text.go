@@ -0,0 +1,168 @@
+// Package text generates plain text documents from the schema, suitable for the
+// plain text boxes of job portals and applicant tracking systems.
+package text
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/seinshah/civic/internal/pkg/output/document"
+	"github.com/seinshah/civic/internal/pkg/types"
+)
+
+type Engine struct {
+	lineWidth int
+}
+
+var _ types.SchemaGenerator = &Engine{}
+
+type Option func(*Engine)
+
+// WithLineWidth sets the maximum number of characters in a line. The lines are wrapped
+// at the word boundaries, and 0 disables the wrapping.
+func WithLineWidth(width int) Option {
+	return func(e *Engine) {
+		e.lineWidth = max(width, 0)
+	}
+}
+
+func NewEngine(opts ...Option) *Engine {
+	engine := &Engine{
+		lineWidth: types.DefaultTextLineWidth,
+	}
+
+	for _, opt := range opts {
+		opt(engine)
+	}
+
+	return engine
+}
+
+// GenerateFromSchema generates a plain text document. Links are replaced with numbered
+// references (e.g. [1]) listed at the end of the document.
+func (e Engine) GenerateFromSchema(_ context.Context, schema *types.Schema) ([]byte, error) {
+	doc := document.New(schema)
+	writer := &textWriter{lineWidth: e.lineWidth}
+
+	writer.line(strings.ToUpper(doc.Name), "")
+	writer.line(doc.Title, "")
+
+	if len(doc.Contact) > 0 {
+		contacts := make([]string, 0, len(doc.Contact))
+
+		for _, contact := range doc.Contact {
+			contacts = append(contacts, writer.link(contact.Text, contact.URL))
+		}
+
+		writer.blank()
+		writer.line(strings.Join(contacts, " | "), "")
+	}
+
+	if doc.About != "" {
+		writer.blank()
+		writer.line(doc.About, "")
+	}
+
+	for _, section := range doc.Sections {
+		writer.heading(section.Header)
+
+		for i, entry := range section.Entries {
+			if i > 0 {
+				writer.blank()
+			}
+
+			writer.entry(entry)
+		}
+	}
+
+	if len(writer.links) > 0 {
+		writer.heading("References")
+
+		// Links are not wrapped, so they can be copied as a whole.
+		for i, url := range writer.links {
+			fmt.Fprintf(&writer.builder, "[%d] %s\n", i+1, url)
+		}
+	}
+
+	return []byte(writer.builder.String()), nil
+}
+
+type textWriter struct {
+	builder   strings.Builder
+	lineWidth int
+	links     []string
+}
+
+func (w *textWriter) heading(header string) {
+	header = strings.ToUpper(strings.TrimSpace(header))
+
+	w.blank()
+	w.line(header, "")
+	w.builder.WriteString(strings.Repeat("=", utf8.RuneCountInString(header)) + "\n")
+}
+
+func (w *textWriter) entry(entry document.Entry) {
+	if entry.Title != "" {
+		w.line(w.link(entry.Title, entry.Link), "")
+	}
+
+	meta := entry.Meta
+	if entry.Subtitle != "" {
+		meta = append([]string{entry.Subtitle}, meta...)
+	}
+
+	if len(meta) > 0 {
+		w.line(strings.Join(meta, " | "), "")
+	}
+
+	if entry.Summary != "" {
+		w.line(entry.Summary, "")
+	}
+
+	for _, detail := range entry.Details {
+		w.line("- "+detail, "  ")
+	}
+}
+
+// link returns the text followed by the number of its reference, or the text as is
+// if there is no URL.
+func (w *textWriter) link(text string, url string) string {
+	if url == "" {
+		return text
+	}
+
+	w.links = append(w.links, url)
+
+	return fmt.Sprintf("%s [%d]", text, len(w.links))
+}
+
+func (w *textWriter) blank() {
+	if w.builder.Len() > 0 {
+		w.builder.WriteString("\n")
+	}
+}
+
+// line writes the text wrapped at the line width. The wrapped lines are prefixed with the indent.
+func (w *textWriter) line(text string, indent string) {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return
+	}
+
+	current := words[0]
+
+	for _, word := range words[1:] {
+		if w.lineWidth > 0 && utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > w.lineWidth {
+			w.builder.WriteString(current + "\n")
+			current = indent + word
+
+			continue
+		}
+
+		current += " " + word
+	}
+
+	w.builder.WriteString(current + "\n")
+}
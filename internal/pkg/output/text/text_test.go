@@ -0,0 +1,118 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/output/text"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_GenerateFromSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{
+		Bio: types.SchemaBio{
+			Name:    "John Doe",
+			Title:   "Programmer",
+			Contact: &types.SchemaBioContact{Location: "Berlin", Email: "john@gmail.com"},
+		},
+		WorkExperiences: &types.SchemaWorkExperiences{
+			Header: "Work Experiences",
+			Entities: []types.SchemaWorkExperienceEntity{
+				{
+					Title:     "Engineer",
+					Company:   "Acme",
+					Location:  "Remote",
+					StartDate: "2020-01",
+					EndDate:   "2021-06",
+					Details: types.NewDetails(
+						"Built the payment service handling millions of transactions every single day",
+					),
+				},
+				{Title: "Intern", Company: "Other", StartDate: "2019", EndDate: "2019"},
+			},
+		},
+		Projects: &types.SchemaProjects{
+			Header:   "Projects",
+			Entities: []types.SchemaProjectsEntity{{Title: "Civic", Link: "https://github.com/seinshah/civic"}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		opts     []text.Option
+		expected string
+	}{
+		{
+			name: "default line width",
+			expected: `JOHN DOE
+Programmer
+
+Berlin | john@gmail.com [1]
+
+WORK EXPERIENCES
+================
+Engineer
+Acme | 2020-01 – 2021-06 | Remote
+- Built the payment service handling millions of transactions every single day
+
+Intern
+Other | 2019 – 2019
+
+PROJECTS
+========
+Civic [2]
+
+REFERENCES
+==========
+[1] mailto:john@gmail.com
+[2] https://github.com/seinshah/civic
+`,
+		},
+		{
+			name: "narrow line width",
+			opts: []text.Option{text.WithLineWidth(30)},
+			expected: `JOHN DOE
+Programmer
+
+Berlin | john@gmail.com [1]
+
+WORK EXPERIENCES
+================
+Engineer
+Acme | 2020-01 – 2021-06 |
+Remote
+- Built the payment service
+  handling millions of
+  transactions every single
+  day
+
+Intern
+Other | 2019 – 2019
+
+PROJECTS
+========
+Civic [2]
+
+REFERENCES
+==========
+[1] mailto:john@gmail.com
+[2] https://github.com/seinshah/civic
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				output, err := text.NewEngine(tc.opts...).GenerateFromSchema(t.Context(), schema)
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, string(output))
+			},
+		)
+	}
+}
@@ -0,0 +1,412 @@
+package types
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var ErrInvalidDate = errors.New("date must be a text in the YYYY, YYYY-MM, or YYYY-MM-DD form, or present")
+
+// Date is a date of the schema in the YYYY, YYYY-MM, or YYYY-MM-DD form, or "present"
+// for ongoing activities. Dates written in the formats that were accepted before
+// (e.g. "Jan 2006" or "01/2006") are still recognized.
+//
+// Printing a date in a template prints it as written in the schema file. Use its methods
+// (e.g. {{.StartDate.Format "Jan 2006"}}) or the date template functions to format it.
+type Date string
+
+// DatePresent is the date of the activities that are still ongoing.
+const DatePresent Date = "present"
+
+type datePrecision int
+
+const (
+	datePrecisionNone datePrecision = iota
+	datePrecisionYear
+	datePrecisionMonth
+	datePrecisionDay
+)
+
+//nolint:gochecknoglobals
+var reISODate = regexp.MustCompile(`^(\d{4})(?:-(\d{1,2})(?:-(\d{1,2}))?)?$`)
+
+// legacyDateLayouts are the common date formats that are recognized in addition
+// to the ISO 8601 dates, along with their precision.
+//
+//nolint:gochecknoglobals
+var legacyDateLayouts = []struct {
+	layout    string
+	precision datePrecision
+}{
+	{"Jan 2006", datePrecisionMonth},
+	{"January 2006", datePrecisionMonth},
+	{"01/2006", datePrecisionMonth},
+	{"1/2006", datePrecisionMonth},
+	{"2006/01", datePrecisionMonth},
+	{"2006/01/02", datePrecisionDay},
+	{"2 Jan 2006", datePrecisionDay},
+	{"Jan 2, 2006", datePrecisionDay},
+}
+
+// UnmarshalJSON accepts the years written as numbers (e.g. 2020) besides the texts.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var number json.Number
+
+	if err := json.Unmarshal(data, &number); err == nil {
+		*d = Date(number.String())
+
+		return nil
+	}
+
+	var text string
+
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidDate, data)
+	}
+
+	*d = Date(text)
+
+	return nil
+}
+
+// UnmarshalTOML accepts the years written as numbers (e.g. 2020) and the TOML dates
+// (e.g. 2020-01-15) besides the texts.
+func (d *Date) UnmarshalTOML(value any) error {
+	switch v := value.(type) {
+	case string:
+		*d = Date(v)
+	case int64:
+		*d = Date(strconv.FormatInt(v, 10))
+	case time.Time:
+		*d = Date(v.Format(time.DateOnly))
+	default:
+		return fmt.Errorf("%w: %v", ErrInvalidDate, value)
+	}
+
+	return nil
+}
+
+// IsPresent reports whether the date refers to an ongoing activity.
+func (d Date) IsPresent() bool {
+	return strings.EqualFold(strings.TrimSpace(string(d)), string(DatePresent))
+}
+
+// IsStructured reports whether the date is either present or a recognized date.
+func (d Date) IsStructured() bool {
+	if d.IsPresent() {
+		return true
+	}
+
+	_, precision := d.parse()
+
+	return precision != datePrecisionNone
+}
+
+// parse returns the first moment of the date along with its precision.
+// The precision is none if the date is empty, present, or not recognized.
+func (d Date) parse() (time.Time, datePrecision) {
+	value := strings.TrimSpace(string(d))
+
+	if match := reISODate.FindStringSubmatch(value); match != nil {
+		precision := datePrecisionYear
+		parts := [3]int{0, 1, 1}
+
+		for i, part := range match[1:] {
+			if part == "" {
+				break
+			}
+
+			parts[i], _ = strconv.Atoi(part)
+			precision = datePrecision(i + 1)
+		}
+
+		parsed := time.Date(parts[0], time.Month(parts[1]), parts[2], 0, 0, 0, 0, time.UTC)
+
+		// time.Date normalizes the out of range values (e.g. month 13), which are invalid dates.
+		if parsed.Year() != parts[0] || int(parsed.Month()) != parts[1] || parsed.Day() != parts[2] {
+			return time.Time{}, datePrecisionNone
+		}
+
+		return parsed, precision
+	}
+
+	for _, layout := range legacyDateLayouts {
+		if parsed, err := time.Parse(layout.layout, value); err == nil {
+			return parsed, layout.precision
+		}
+	}
+
+	return time.Time{}, datePrecisionNone
+}
+
+// Time returns the first day of the date, or the current time if the date is present.
+// The zero time is returned if the date is not recognized.
+func (d Date) Time() time.Time {
+	if d.IsPresent() {
+		return time.Now().UTC()
+	}
+
+	parsed, _ := d.parse()
+
+	return parsed
+}
+
+// ISO returns the date in the ISO 8601 form with the same precision as written
+// (e.g. "Jan 2006" becomes 2006-01). An empty string is returned for present and
+// not recognized dates.
+func (d Date) ISO() string {
+	parsed, precision := d.parse()
+
+	switch precision {
+	case datePrecisionYear:
+		return parsed.Format("2006")
+	case datePrecisionMonth:
+		return parsed.Format("2006-01")
+	case datePrecisionDay:
+		return parsed.Format(time.DateOnly)
+	case datePrecisionNone:
+	}
+
+	return ""
+}
+
+// Compare returns -1, 0, or +1 depending on whether the date is before, the same as, or after
+// the other date, considering the lowest precision of the two. Present is after any other date,
+// and not recognized dates are before any recognized date.
+func (d Date) Compare(other Date) int {
+	if d.IsPresent() || other.IsPresent() {
+		return cmp.Compare(boolToInt(d.IsPresent()), boolToInt(other.IsPresent()))
+	}
+
+	first, firstPrecision := d.parse()
+	second, secondPrecision := other.parse()
+
+	switch min(firstPrecision, secondPrecision) {
+	case datePrecisionNone:
+		return cmp.Compare(firstPrecision, secondPrecision)
+	case datePrecisionYear:
+		return cmp.Compare(first.Year(), second.Year())
+	case datePrecisionMonth:
+		return cmp.Or(cmp.Compare(first.Year(), second.Year()), cmp.Compare(first.Month(), second.Month()))
+	case datePrecisionDay:
+	}
+
+	return first.Compare(second)
+}
+
+// Format formats the date using the Go time layout (e.g. "Jan 2006").
+// Present is formatted as "Present" and not recognized dates are returned as written.
+func (d Date) Format(layout string) string {
+	return d.FormatLocale("en", layout)
+}
+
+// FormatLocale formats the date like Format while using the month names of the locale
+// (e.g. "de" or "fr-CA"). Unsupported locales fall back to English.
+func (d Date) FormatLocale(locale string, layout string) string {
+	names := localeNames(locale)
+
+	if d.IsPresent() {
+		return names.present
+	}
+
+	parsed, precision := d.parse()
+	if precision == datePrecisionNone {
+		return string(d)
+	}
+
+	// The month names are replaced by placeholders that time.Format does not interpret.
+	layout = strings.NewReplacer("January", "\x00", "Jan", "\x01").Replace(layout)
+
+	return strings.NewReplacer(
+		"\x00", names.months[parsed.Month()-1],
+		"\x01", names.shortMonths[parsed.Month()-1],
+	).Replace(parsed.Format(layout))
+}
+
+// Until returns the duration between the date and the end date in years and months
+// (e.g. "2 yrs 3 mos"). Both months are included, so an activity that started and ended
+// in the same month lasts 1 month. Dates without a month are considered to start in January
+// and end in December. An empty string is returned if any date is not recognized or the end
+// date is before the date.
+func (d Date) Until(end Date) string {
+	if d.IsPresent() {
+		return ""
+	}
+
+	start, startPrecision := d.parse()
+	finish, finishPrecision := end.Time(), datePrecisionMonth
+
+	if !end.IsPresent() {
+		finish, finishPrecision = end.parse()
+	}
+
+	if startPrecision == datePrecisionNone || finishPrecision == datePrecisionNone {
+		return ""
+	}
+
+	finishMonth := int(finish.Month())
+	if finishPrecision == datePrecisionYear {
+		finishMonth = int(time.December)
+	}
+
+	months := (finish.Year()-start.Year())*12 + finishMonth - int(start.Month()) + 1
+	if months <= 0 {
+		return ""
+	}
+
+	var parts []string
+
+	if years := months / 12; years > 0 {
+		parts = append(parts, pluralize(years, "yr", "yrs"))
+	}
+
+	if months%12 > 0 {
+		parts = append(parts, pluralize(months%12, "mo", "mos"))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// DateFuncs returns the template functions to work with the dates. The formatted date is the last
+// argument, so the functions can be used in pipelines (e.g. {{.StartDate | formatDate "Jan 2006"}}),
+// and tenure returns the duration between two dates (e.g. {{tenure .StartDate .EndDate}}).
+func DateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"formatDate": func(layout string, date Date) string {
+			return date.Format(layout)
+		},
+		"formatDateLocale": func(locale string, layout string, date Date) string {
+			return date.FormatLocale(locale, layout)
+		},
+		"tenure": func(start Date, end Date) string {
+			return start.Until(end)
+		},
+	}
+}
+
+func pluralize(count int, singular string, plural string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, singular)
+	}
+
+	return fmt.Sprintf("%d %s", count, plural)
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+// isValidDate validates that a date is either present or a recognized date.
+func isValidDate(fl validator.FieldLevel) bool {
+	return Date(fl.Field().String()).IsStructured()
+}
+
+// isDateAfter validates that the date is not before the date of the field provided
+// as the parameter (e.g. dateafter=StartDate). Empty and not recognized dates are ignored.
+func isDateAfter(fl validator.FieldLevel) bool {
+	start := fl.Parent().FieldByName(fl.Param())
+	if !start.IsValid() {
+		return false
+	}
+
+	date := Date(fl.Field().String())
+	startDate := Date(start.String())
+
+	if !date.IsStructured() || !startDate.IsStructured() {
+		return true
+	}
+
+	return date.Compare(startDate) >= 0
+}
+
+type dateLocaleNames struct {
+	months      [12]string
+	shortMonths [12]string
+	present     string
+}
+
+//nolint:gochecknoglobals
+var dateLocales = map[string]dateLocaleNames{
+	"en": {
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		present:     "Present",
+	},
+	"de": {
+		months: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		shortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		present:     "Heute",
+	},
+	"es": {
+		months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		present:     "Actualidad",
+	},
+	"fr": {
+		months: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		shortMonths: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		present: "Aujourd'hui",
+	},
+	"it": {
+		months: [12]string{
+			"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+			"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre",
+		},
+		shortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		present:     "Oggi",
+	},
+	"nl": {
+		months: [12]string{
+			"januari", "februari", "maart", "april", "mei", "juni",
+			"juli", "augustus", "september", "oktober", "november", "december",
+		},
+		shortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		present:     "Heden",
+	},
+	"pt": {
+		months: [12]string{
+			"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+		},
+		shortMonths: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		present:     "Atual",
+	},
+}
+
+// localeNames returns the names of the locale (e.g. de-AT uses de), or English if it is not supported.
+func localeNames(locale string) dateLocaleNames {
+	language, _, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(locale, "_", "-")), "-")
+
+	if names, ok := dateLocales[language]; ok {
+		return names
+	}
+
+	return dateLocales["en"]
+}
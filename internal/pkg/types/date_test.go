@@ -0,0 +1,149 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate_ISO(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		date       types.Date
+		expected   string
+		structured bool
+	}{
+		{date: "2020", expected: "2020", structured: true},
+		{date: " 2020-3 ", expected: "2020-03", structured: true},
+		{date: "2020-03-15", expected: "2020-03-15", structured: true},
+		{date: "Mar 2020", expected: "2020-03", structured: true},
+		{date: "03/2020", expected: "2020-03", structured: true},
+		{date: "Mar 15, 2020", expected: "2020-03-15", structured: true},
+		{date: "Present", structured: true},
+		{date: "2021-02-29"},
+		{date: "2020-13"},
+		{date: "last summer"},
+		{date: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			string(tc.date), func(t *testing.T) {
+				t.Parallel()
+
+				require.Equal(t, tc.expected, tc.date.ISO())
+				require.Equal(t, tc.structured, tc.date.IsStructured())
+			},
+		)
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		first    types.Date
+		second   types.Date
+		expected int
+	}{
+		{first: "2020", second: "2020-05", expected: 0},
+		{first: "2020-04", second: "2020-05-01", expected: -1},
+		{first: "2020-05-02", second: "2020-05-01", expected: 1},
+		{first: "Jan 2021", second: "2020-12-31", expected: 1},
+		{first: "present", second: "2099", expected: 1},
+		{first: "present", second: "Present", expected: 0},
+		{first: "unknown", second: "2020", expected: -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			string(tc.first)+" "+string(tc.second), func(t *testing.T) {
+				t.Parallel()
+
+				require.Equal(t, tc.expected, tc.first.Compare(tc.second))
+				require.Equal(t, -tc.expected, tc.second.Compare(tc.first))
+			},
+		)
+	}
+}
+
+func TestDate_FormatLocale(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		date     types.Date
+		locale   string
+		layout   string
+		expected string
+	}{
+		{date: "2020-03-15", locale: "en", layout: "Jan 2006", expected: "Mar 2020"},
+		{date: "2020-03-15", locale: "de-AT", layout: "2. January 2006", expected: "15. März 2020"},
+		{date: "2020-05", locale: "fr", layout: "Jan 2006", expected: "mai 2020"},
+		{date: "2020-06", locale: "xx", layout: "January 2006", expected: "June 2020"},
+		{date: "present", locale: "es", layout: "Jan 2006", expected: "Actualidad"},
+		{date: "last summer", locale: "en", layout: "Jan 2006", expected: "last summer"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			string(tc.date)+" "+tc.locale, func(t *testing.T) {
+				t.Parallel()
+
+				require.Equal(t, tc.expected, tc.date.FormatLocale(tc.locale, tc.layout))
+			},
+		)
+	}
+}
+
+func TestDate_Until(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		start    types.Date
+		end      types.Date
+		expected string
+	}{
+		{start: "2020-01", end: "2020-01", expected: "1 mo"},
+		{start: "2020-01", end: "2020-12", expected: "1 yr"},
+		{start: "2019-10-05", end: "2022-03-01", expected: "2 yrs 6 mos"},
+		{start: "2018", end: "2019", expected: "2 yrs"},
+		{start: "Jan 2020", end: "2020-04", expected: "4 mos"},
+		{start: "2020-05", end: "2020-01"},
+		{start: "2020-05", end: "unknown"},
+		{start: "present", end: "present"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			string(tc.start)+" "+string(tc.end), func(t *testing.T) {
+				t.Parallel()
+
+				require.Equal(t, tc.expected, tc.start.Until(tc.end))
+			},
+		)
+	}
+
+	require.NotEmpty(t, types.Date("2020-01").Until(types.DatePresent))
+}
+
+func TestDateFuncs(t *testing.T) {
+	t.Parallel()
+
+	tpl, err := template.New("test").Funcs(types.DateFuncs()).Parse(
+		`{{.Start}}|{{.Start | formatDate "Jan 2006"}}|{{formatDateLocale "it" "January 2006" .End}}|` +
+			`{{tenure .Start .End}}|{{.Start.Format "2006"}}`,
+	)
+
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+
+	require.NoError(
+		t, tpl.Execute(&output, map[string]types.Date{"Start": "2019-11", "End": "2021-02"}),
+	)
+	require.Equal(t, "2019-11|Nov 2019|febbraio 2021|1 yr 4 mos|2019", output.String())
+}
@@ -1,6 +1,9 @@
 package types
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 const (
 	DefaultAppName            = "civic"
@@ -9,7 +12,28 @@ const (
 	DefaultSchemaFileName     = "." + DefaultAppName + ".yaml"
 	DefaultSchemaJSONFileName = DefaultAppName + "-jsonschema.json"
 	DefaultPageSize           = PageSizeA4
+	DefaultPageMinScale       = 0.7
+	DefaultTemplateName       = "genesis"
+	DefaultLaTeXTemplateName  = "moderncv"
 	DefaultFilePermission     = 0o600
+	DefaultDirPermission      = 0o700
+	DefaultTextLineWidth      = 80
+	DefaultImageDPI           = 150
+	DefaultServeAddress       = "localhost:8080"
+	DefaultAPIAddress         = "localhost:8090"
+	DefaultAPIMaxBodySize     = 1 << 20
+	DefaultAPITimeout         = 30 * time.Second
+	DefaultAPIMaxTabs         = 4
+	DefaultBatchWorkers       = 4
+)
+
+const (
+	EnvChromeURL      = "CIVIC_CHROME_URL"
+	EnvChromeExecPath = "CIVIC_CHROME_PATH"
+
+	// EnvSourceDateEpoch is the standard variable of the reproducible builds, holding
+	// the Unix timestamp to use instead of the current time.
+	EnvSourceDateEpoch = "SOURCE_DATE_EPOCH"
 )
 
 func CurrentWDPath(filename string) string {
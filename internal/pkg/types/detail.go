@@ -0,0 +1,161 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/invopop/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidDetail = errors.New("detail must be either a text or an object with text and tags")
+
+// SchemaDetail is a single line of details. It can be provided either as a plain text,
+// or as an object with the text and the tags of the line to filter it when needed.
+type SchemaDetail struct {
+	// Text is the content of the detail line.
+	Text string `json:"text" yaml:"text"`
+
+	// Tags are the arbitrary labels of this line used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" yaml:"tags"`
+}
+
+// schemaDetailObject has the same structure as SchemaDetail without its custom decoders.
+type schemaDetailObject SchemaDetail
+
+// String returns the text of the detail, so templates can print the detail directly.
+func (d SchemaDetail) String() string {
+	return d.Text
+}
+
+func (d *SchemaDetail) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		d.Text = node.Value
+
+		return nil
+
+	case yaml.MappingNode:
+		return node.Decode((*schemaDetailObject)(d))
+
+	default:
+		return fmt.Errorf("%w: line %d", ErrInvalidDetail, node.Line)
+	}
+}
+
+func (d *SchemaDetail) UnmarshalJSON(data []byte) error {
+	var text string
+
+	if err := json.Unmarshal(data, &text); err == nil {
+		d.Text = text
+
+		return nil
+	}
+
+	if err := json.Unmarshal(data, (*schemaDetailObject)(d)); err != nil {
+		return errors.Join(ErrInvalidDetail, err)
+	}
+
+	return nil
+}
+
+// UnmarshalTOML receives the value already decoded by the TOML decoder.
+func (d *SchemaDetail) UnmarshalTOML(value any) error {
+	switch v := value.(type) {
+	case string:
+		d.Text = v
+
+	case map[string]any:
+		text, _ := v["text"].(string)
+		d.Text = text
+
+		tags, _ := v["tags"].([]any)
+		for _, tag := range tags {
+			tagText, ok := tag.(string)
+			if !ok {
+				return ErrInvalidDetail
+			}
+
+			d.Tags = append(d.Tags, tagText)
+		}
+
+	default:
+		return ErrInvalidDetail
+	}
+
+	return nil
+}
+
+// MarshalJSON writes the detail as a plain text when it has no tags.
+func (d SchemaDetail) MarshalJSON() ([]byte, error) {
+	if len(d.Tags) == 0 {
+		return json.Marshal(d.Text)
+	}
+
+	return json.Marshal(schemaDetailObject(d))
+}
+
+// MarshalYAML writes the detail as a plain text when it has no tags.
+func (d SchemaDetail) MarshalYAML() (any, error) {
+	if len(d.Tags) == 0 {
+		return d.Text, nil
+	}
+
+	return schemaDetailObject(d), nil
+}
+
+// JSONSchema describes both accepted forms of a detail in the JSON schema.
+func (SchemaDetail) JSONSchema() *jsonschema.Schema {
+	properties := jsonschema.NewProperties()
+
+	properties.Set("text", &jsonschema.Schema{
+		Type:        "string",
+		Description: "Text is the content of the detail line.",
+	})
+	properties.Set("tags", &jsonschema.Schema{
+		Type:        "array",
+		Items:       &jsonschema.Schema{Type: "string"},
+		Description: "Tags are the arbitrary labels of this line used to include or exclude it.",
+	})
+
+	return &jsonschema.Schema{
+		OneOf: []*jsonschema.Schema{
+			{Type: "string"},
+			{
+				Type:                 "object",
+				Properties:           properties,
+				Required:             []string{"text"},
+				AdditionalProperties: jsonschema.FalseSchema,
+			},
+		},
+		Description: "A detail line provided either as a text, or as an object with text and tags.",
+	}
+}
+
+// detailText lets the validator treat the detail as its text, so the string rules
+// (e.g. min) apply to the text of each detail line.
+func detailText(field reflect.Value) any {
+	if detail, ok := field.Interface().(SchemaDetail); ok {
+		return detail.Text
+	}
+
+	return nil
+}
+
+// NewDetails is a helper to create detail lines without any tags from plain texts.
+func NewDetails(texts ...string) []SchemaDetail {
+	if len(texts) == 0 {
+		return nil
+	}
+
+	details := make([]SchemaDetail, 0, len(texts))
+
+	for _, text := range texts {
+		details = append(details, SchemaDetail{Text: text})
+	}
+
+	return details
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaDetail_Formats(t *testing.T) {
+	t.Parallel()
+
+	expected := []types.SchemaDetail{
+		{Text: "plain line"},
+		{Text: "tagged line", Tags: []string{"backend", "go"}},
+	}
+
+	testCases := []struct {
+		name        string
+		content     string
+		contentType types.SchemaType
+		hasError    bool
+	}{
+		{
+			name: "yaml",
+			content: `template: {path: "path"}
+bio: {name: "John Doe", title: "Engineer"}
+customSections:
+  - header: "Extra"
+    details:
+      - "plain line"
+      - {text: "tagged line", tags: [backend, go]}`,
+			contentType: types.SchemaTypeYaml,
+		},
+		{
+			name: "json",
+			content: `{
+  "template": {"path": "path"},
+  "bio": {"name": "John Doe", "title": "Engineer"},
+  "customSections": [
+    {"header": "Extra", "details": ["plain line", {"text": "tagged line", "tags": ["backend", "go"]}]}
+  ]
+}`,
+			contentType: types.SchemaTypeJson,
+		},
+		{
+			name: "toml",
+			content: `[template]
+path = "path"
+
+[bio]
+name = "John Doe"
+title = "Engineer"
+
+[[customSections]]
+header = "Extra"
+details = ["plain line", {text = "tagged line", tags = ["backend", "go"]}]`,
+			contentType: types.SchemaTypeToml,
+		},
+		{
+			name:        "invalid yaml detail",
+			content:     `customSections: [{header: "Extra", details: [[nested]]}]`,
+			contentType: types.SchemaTypeYaml,
+			hasError:    true,
+		},
+		{
+			name:        "invalid json detail",
+			content:     `{"customSections": [{"header": "Extra", "details": [1]}]}`,
+			contentType: types.SchemaTypeJson,
+			hasError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				data, err := types.NewSchema([]byte(tc.content), tc.contentType)
+
+				if tc.hasError {
+					require.Error(t, err)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.NoError(t, data.IsValid())
+				require.Len(t, data.CustomSections, 1)
+				require.Equal(t, expected, data.CustomSections[0].Details)
+			},
+		)
+	}
+}
+
+func TestSchemaDetail_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	content, err := json.Marshal(
+		[]types.SchemaDetail{
+			{Text: "plain line"},
+			{Text: "tagged line", Tags: []string{"go"}},
+		},
+	)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `["plain line", {"text": "tagged line", "tags": ["go"]}]`, string(content))
+}
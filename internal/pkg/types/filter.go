@@ -0,0 +1,179 @@
+package types
+
+import "slices"
+
+// TagFilter defines which tagged parts of the schema should be kept.
+//   - Anything having at least one of the Exclude tags is removed.
+//   - If Include is not empty, anything tagged is only kept if it has at least one of the Include tags.
+//   - Anything without tags is always kept unless it is excluded by the previous rules.
+type TagFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// IsEmpty reports whether the filter has no effect.
+func (f TagFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Matches reports whether something with the provided tags should be kept.
+func (f TagFilter) Matches(tags []string) bool {
+	for _, tag := range tags {
+		if slices.Contains(f.Exclude, tag) {
+			return false
+		}
+	}
+
+	if len(f.Include) == 0 || len(tags) == 0 {
+		return true
+	}
+
+	for _, tag := range tags {
+		if slices.Contains(f.Include, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterByTags removes the entities and detail lines that do not match the filter.
+// Sections that end up without any entity are removed as a whole, so the filtered
+// schema remains valid.
+func (s *Schema) FilterByTags(filter TagFilter) {
+	if filter.IsEmpty() {
+		return
+	}
+
+	if s.WorkExperiences != nil {
+		s.WorkExperiences.Entities = filterEntities(
+			s.WorkExperiences.Entities, filter,
+			func(e SchemaWorkExperienceEntity) []string { return e.Tags },
+			func(e *SchemaWorkExperienceEntity) { e.Details = filterDetails(e.Details, filter) },
+		)
+
+		if len(s.WorkExperiences.Entities) == 0 {
+			s.WorkExperiences = nil
+		}
+	}
+
+	if s.Educations != nil {
+		s.Educations.Entities = filterEntities(
+			s.Educations.Entities, filter,
+			func(e SchemaEducationsEntity) []string { return e.Tags },
+			func(e *SchemaEducationsEntity) { e.Details = filterDetails(e.Details, filter) },
+		)
+
+		if len(s.Educations.Entities) == 0 {
+			s.Educations = nil
+		}
+	}
+
+	if s.Certificates != nil {
+		s.Certificates.Entities = filterEntities(
+			s.Certificates.Entities, filter,
+			func(e SchemaCertificatesEntity) []string { return e.Tags },
+			nil,
+		)
+
+		if len(s.Certificates.Entities) == 0 {
+			s.Certificates = nil
+		}
+	}
+
+	if s.Publications != nil {
+		s.Publications.Entities = filterEntities(
+			s.Publications.Entities, filter,
+			func(e SchemaPublicationsEntity) []string { return e.Tags },
+			func(e *SchemaPublicationsEntity) { e.Details = filterDetails(e.Details, filter) },
+		)
+
+		if len(s.Publications.Entities) == 0 {
+			s.Publications = nil
+		}
+	}
+
+	if s.Skills != nil {
+		s.Skills.Entities = filterSkills(s.Skills.Entities, filter)
+
+		if len(s.Skills.Entities) == 0 {
+			s.Skills = nil
+		}
+	}
+
+	if s.Projects != nil {
+		s.Projects.Entities = filterEntities(
+			s.Projects.Entities, filter,
+			func(e SchemaProjectsEntity) []string { return e.Tags },
+			func(e *SchemaProjectsEntity) { e.Details = filterDetails(e.Details, filter) },
+		)
+
+		if len(s.Projects.Entities) == 0 {
+			s.Projects = nil
+		}
+	}
+
+	s.CustomSections = filterCustomSections(s.CustomSections, filter)
+}
+
+// filterEntities keeps the entities matching the filter. The optional nested function
+// is called on every kept entity to filter its nested parts.
+func filterEntities[E any](entities []E, filter TagFilter, tags func(E) []string, nested func(*E)) []E {
+	filtered := make([]E, 0, len(entities))
+
+	for _, entity := range entities {
+		if !filter.Matches(tags(entity)) {
+			continue
+		}
+
+		if nested != nil {
+			nested(&entity)
+		}
+
+		filtered = append(filtered, entity)
+	}
+
+	return filtered
+}
+
+func filterDetails(details []SchemaDetail, filter TagFilter) []SchemaDetail {
+	if details == nil {
+		return nil
+	}
+
+	return filterEntities(details, filter, func(d SchemaDetail) []string { return d.Tags }, nil)
+}
+
+func filterSkills(entities []SchemaSkillsEntity, filter TagFilter) []SchemaSkillsEntity {
+	filtered := make([]SchemaSkillsEntity, 0, len(entities))
+
+	for _, entity := range entities {
+		entity.Items = filterEntities(
+			entity.Items, filter, func(i SchemaSkillsEntityItem) []string { return i.Tags }, nil,
+		)
+
+		if len(entity.Items) > 0 {
+			filtered = append(filtered, entity)
+		}
+	}
+
+	return filtered
+}
+
+func filterCustomSections(sections []SchemaCustomSection, filter TagFilter) []SchemaCustomSection {
+	if sections == nil {
+		return nil
+	}
+
+	filtered := make([]SchemaCustomSection, 0, len(sections))
+
+	for _, section := range sections {
+		section.Details = filterDetails(section.Details, filter)
+
+		if len(section.Details) > 0 {
+			filtered = append(filtered, section)
+		}
+	}
+
+	return filtered
+}
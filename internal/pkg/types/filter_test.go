@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		filter   types.TagFilter
+		tags     []string
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			tags:     []string{"go"},
+			expected: true,
+		},
+		{
+			name:     "untagged with include",
+			filter:   types.TagFilter{Include: []string{"go"}},
+			expected: true,
+		},
+		{
+			name:     "included",
+			filter:   types.TagFilter{Include: []string{"go", "rust"}},
+			tags:     []string{"rust"},
+			expected: true,
+		},
+		{
+			name:     "not included",
+			filter:   types.TagFilter{Include: []string{"go"}},
+			tags:     []string{"rust"},
+			expected: false,
+		},
+		{
+			name:     "excluded",
+			filter:   types.TagFilter{Exclude: []string{"rust"}},
+			tags:     []string{"go", "rust"},
+			expected: false,
+		},
+		{
+			name:     "exclude takes precedence",
+			filter:   types.TagFilter{Include: []string{"go"}, Exclude: []string{"rust"}},
+			tags:     []string{"go", "rust"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				require.Equal(t, tc.expected, tc.filter.Matches(tc.tags))
+			},
+		)
+	}
+}
+
+func TestSchema_FilterByTags(t *testing.T) {
+	t.Parallel()
+
+	content := `template: {path: "path"}
+bio: {name: "John Doe", title: "Engineer"}
+workExperiences:
+  entities:
+    - title: "Backend Engineer"
+      company: "Acme"
+      startDate: "2020"
+      tags: [backend]
+      details:
+        - "general line"
+        - {text: "go line", tags: [backend]}
+        - {text: "react line", tags: [frontend]}
+    - {title: "Frontend Engineer", company: "Globex", startDate: "2018", tags: [frontend]}
+    - {title: "Intern", company: "Initech", startDate: "2017"}
+projects:
+  entities:
+    - {name: "UI Kit", tags: [frontend]}
+skills:
+  entities:
+    - {category: "Backend", items: [{name: "Go", tags: [backend]}]}
+    - {category: "Frontend", items: [{name: "React", tags: [frontend]}]}
+customSections:
+  - {header: "Talks", details: [{text: "frontend talk", tags: [frontend]}]}
+  - {header: "Hobbies", details: ["hiking"]}`
+
+	data, err := types.NewSchema([]byte(content), types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+
+	data.FilterByTags(types.TagFilter{Include: []string{"backend"}})
+
+	require.NoError(t, data.IsValid())
+
+	require.NotNil(t, data.WorkExperiences)
+	require.Len(t, data.WorkExperiences.Entities, 2)
+	require.Equal(t, "Acme", data.WorkExperiences.Entities[0].Company)
+	require.Equal(
+		t,
+		[]types.SchemaDetail{{Text: "general line"}, {Text: "go line", Tags: []string{"backend"}}},
+		data.WorkExperiences.Entities[0].Details,
+	)
+	require.Equal(t, "Initech", data.WorkExperiences.Entities[1].Company)
+
+	require.Nil(t, data.Projects)
+
+	require.NotNil(t, data.Skills)
+	require.Len(t, data.Skills.Entities, 1)
+	require.Equal(t, "Backend", data.Skills.Entities[0].Category)
+
+	require.Len(t, data.CustomSections, 1)
+	require.Equal(t, "Hobbies", data.CustomSections[0].Header)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+//nolint:gochecknoglobals
+var (
+	latexEscaper = strings.NewReplacer(
+		`\`, `\textbackslash{}`,
+		`{`, `\{`,
+		`}`, `\}`,
+		`$`, `\$`,
+		`&`, `\&`,
+		`#`, `\#`,
+		`%`, `\%`,
+		`_`, `\_`,
+		`^`, `\textasciicircum{}`,
+		`~`, `\textasciitilde{}`,
+		`<`, `\textless{}`,
+		`>`, `\textgreater{}`,
+	)
+
+	latexURLEscaper = strings.NewReplacer(
+		`\`, `%5C`,
+		`{`, `%7B`,
+		`}`, `%7D`,
+		`%`, `\%`,
+		`#`, `\#`,
+	)
+)
+
+// EscapeLaTeX escapes the characters having a special meaning in LaTeX, so the value
+// is printed as is. Any value that is not a string (e.g. a detail line) is printed
+// in its default format.
+func EscapeLaTeX(value any) string {
+	return latexEscaper.Replace(fmt.Sprint(value))
+}
+
+// EscapeLaTeXURL escapes the provided link to be used as the URL argument of \href and \url.
+func EscapeLaTeXURL(value any) string {
+	return latexURLEscaper.Replace(fmt.Sprint(value))
+}
+
+// LaTeXFuncs returns the template functions to escape the values in the LaTeX templates.
+// The text/template package does not escape the values, so the templates must escape
+// them with latex (e.g. {{latex .Schema.Bio.Name}}) and the links with latexURL.
+func LaTeXFuncs() template.FuncMap {
+	return template.FuncMap{
+		"latex":    EscapeLaTeX,
+		"latexURL": EscapeLaTeXURL,
+	}
+}
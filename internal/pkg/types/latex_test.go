@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaTeXFuncs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		template string
+		data     any
+		expected string
+	}{
+		{
+			name:     "plain text",
+			template: `{{latex .}}`,
+			data:     "John Doe",
+			expected: "John Doe",
+		},
+		{
+			name:     "special characters",
+			template: `{{latex .}}`,
+			data:     `R&D #1: 50% of $10_000 {fast} ~ ^ \ <>`,
+			expected: `R\&D \#1: 50\% of \$10\_000 \{fast\} \textasciitilde{} \textasciicircum{} ` +
+				`\textbackslash{} \textless{}\textgreater{}`,
+		},
+		{
+			name:     "detail line",
+			template: `{{latex .}}`,
+			data:     types.SchemaDetail{Text: "Cut costs by 20%", Tags: []string{"go"}},
+			expected: `Cut costs by 20\%`,
+		},
+		{
+			name:     "url",
+			template: `{{latexURL .}}`,
+			data:     "https://example.com/a_b?q=50%25#top",
+			expected: `https://example.com/a_b?q=50\%25\#top`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				tpl, err := template.New("test").Funcs(types.LaTeXFuncs()).Parse(tc.template)
+				require.NoError(t, err)
+
+				var output bytes.Buffer
+
+				require.NoError(t, tpl.Execute(&output, tc.data))
+				require.Equal(t, tc.expected, output.String())
+			},
+		)
+	}
+}
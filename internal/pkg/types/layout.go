@@ -0,0 +1,35 @@
+package types
+
+// LayoutReport is the layout of a document printed in pages (e.g. the PDF), collected by
+// the generator while printing it.
+type LayoutReport struct {
+	// Pages is the number of printed pages.
+	Pages int `json:"pages"`
+
+	// Scale is the scale of the printed content. It is below 1 if the content is scaled down
+	// to fit in the maximum number of pages.
+	Scale float64 `json:"scale"`
+
+	// SplitSections are the sections of the template breaking across pages.
+	SplitSections []SplitSection `json:"splitSections,omitempty"`
+
+	// Overflows are the elements wider than the printable width of the page, which are cut off.
+	Overflows []LayoutOverflow `json:"overflows,omitempty"`
+}
+
+// SplitSection is a section of the template that starts and ends on different pages.
+type SplitSection struct {
+	// Name is the data-section attribute, the ID, or the heading of the section element.
+	Name      string `json:"name"`
+	FirstPage int    `json:"firstPage"`
+	LastPage  int    `json:"lastPage"`
+}
+
+// LayoutOverflow is an element wider than the printable width of the page.
+type LayoutOverflow struct {
+	// Element describes the element by its tag, ID, and class (e.g. div#header.title).
+	Element string `json:"element"`
+
+	// Overflow is the amount of the element beyond the printable width of the page.
+	Overflow Length `json:"overflow"`
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidLength = errors.New("length must be a number in inch, or a text with the in, cm, mm, or pt unit")
+
+// Length is a dimension of the page in inch. In the schema file, it is either a number
+// in inch, or a text having one of the in, cm, mm, and pt units (e.g. "12mm"), which is
+// converted to inch when the schema file is loaded.
+//
+// Printing a length in a template prints the number of inches, e.g. {{.Top}}in.
+type Length float64
+
+//nolint:gochecknoglobals
+var (
+	reLength = regexp.MustCompile(`^(\d+(?:\.\d*)?|\.\d+)\s*(in|cm|mm|pt)?$`)
+
+	lengthUnits = map[string]float64{
+		"":   1,
+		"in": 1,
+		"cm": 1 / 2.54, //nolint:mnd
+		"mm": 1 / 25.4, //nolint:mnd
+		"pt": 1.0 / 72, //nolint:mnd
+	}
+)
+
+// ParseLength parses a length written as a number in inch, or with its unit (e.g. "12mm").
+func ParseLength(text string) (Length, error) {
+	match := reLength.FindStringSubmatch(strings.ToLower(strings.TrimSpace(text)))
+	if match == nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLength, text)
+	}
+
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLength, text)
+	}
+
+	return Length(value * lengthUnits[match[2]]), nil
+}
+
+// CSS returns the length in inch for the style sheets, e.g. "0.5in".
+func (l Length) CSS() string {
+	return strconv.FormatFloat(float64(l), 'f', -1, 64) + "in"
+}
+
+func (l *Length) UnmarshalJSON(data []byte) error {
+	var number float64
+
+	if err := json.Unmarshal(data, &number); err == nil {
+		*l = Length(number)
+
+		return nil
+	}
+
+	var text string
+
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidLength, data)
+	}
+
+	length, err := ParseLength(text)
+	if err != nil {
+		return err
+	}
+
+	*l = length
+
+	return nil
+}
+
+func (l *Length) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("%w: line %d", ErrInvalidLength, node.Line)
+	}
+
+	length, err := ParseLength(node.Value)
+	if err != nil {
+		return fmt.Errorf("%w (line %d)", err, node.Line)
+	}
+
+	*l = length
+
+	return nil
+}
+
+// UnmarshalTOML receives the value already decoded by the TOML decoder.
+func (l *Length) UnmarshalTOML(value any) error {
+	switch v := value.(type) {
+	case int64:
+		*l = Length(v)
+	case float64:
+		*l = Length(v)
+	case string:
+		length, err := ParseLength(v)
+		if err != nil {
+			return err
+		}
+
+		*l = length
+	default:
+		return fmt.Errorf("%w: %v", ErrInvalidLength, value)
+	}
+
+	return nil
+}
+
+// JSONSchema describes both accepted forms of a length in the JSON schema.
+func (Length) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		OneOf: []*jsonschema.Schema{
+			{Type: "number", Minimum: json.Number("0")},
+			{Type: "string", Pattern: `^\s*(\d+(\.\d*)?|\.\d+)\s*(in|cm|mm|pt)?\s*$`},
+		},
+		Description: "A length provided either as a number in inch, or as a text with the in, cm, mm, or pt unit.",
+	}
+}
@@ -1,18 +1,24 @@
 package types
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
+	"text/template"
 )
 
-var ErrEmptyOutputPath = errors.New("output path is empty")
+var (
+	ErrEmptyOutputPath   = errors.New("output path is empty")
+	ErrInvalidOutputPath = errors.New("output path pattern cannot be rendered")
+)
 
 //go:generate go tool go-enum --names
 
 // OutputType is the type of CV output being generated by the binary.
-// ENUM(pdf, html).
+// ENUM(pdf, html, json, docx, md, txt, tex, png, jpg, webp).
 type OutputType string
 
 // OutputGenerator is an interface that each output generator need to implement.
@@ -21,6 +27,29 @@ type OutputGenerator interface {
 	Generate(ctx context.Context, content []byte) ([]byte, error)
 }
 
+// PagedOutputGenerator is an interface that the generators able to generate one output per
+// page of the parsed HTML template (e.g. images) need to implement.
+type PagedOutputGenerator interface {
+	GeneratePages(ctx context.Context, content []byte) ([][]byte, error)
+}
+
+// ReportingOutputGenerator is an interface that the generators able to report the layout of
+// the document they print in pages (e.g. the PDF) need to implement.
+type ReportingOutputGenerator interface {
+	GenerateWithReport(ctx context.Context, content []byte) ([]byte, *LayoutReport, error)
+}
+
+// SchemaGenerator is an interface that the generators building the output directly from
+// the schema need to implement. These generators (e.g. data formats) do not use the template.
+type SchemaGenerator interface {
+	GenerateFromSchema(ctx context.Context, schema *Schema) ([]byte, error)
+}
+
+// IsImage reports whether the output type is an image format.
+func (x OutputType) IsImage() bool {
+	return x == OutputTypePng || x == OutputTypeJpg || x == OutputTypeWebp
+}
+
 // DetectFileType detects the file type from the file path extension.
 // It casts the detected extension to the provided type.
 func DetectFileType[C ~string](outputPath string) C {
@@ -28,3 +57,38 @@ func DetectFileType[C ~string](outputPath string) C {
 
 	return C(strings.ToLower(ext))
 }
+
+// OutputPathData is the data available to the output path when it is a text/template pattern
+// (e.g. "cv-{{.Profile}}.pdf"). All the schema fields are accessible as well (e.g. {{.Bio.Name}}).
+type OutputPathData struct {
+	*Schema
+
+	// Profile is the name of the selected profile, or empty if no profile is selected.
+	Profile string
+}
+
+// IsOutputPathPattern reports whether the output path is a text/template pattern.
+func IsOutputPathPattern(outputPath string) bool {
+	return strings.Contains(outputPath, "{{")
+}
+
+// RenderOutputPath renders the output path pattern for the provided schema and profile.
+// If the output path is not a pattern, it is returned as is.
+func RenderOutputPath(pattern string, schema *Schema, profile string) (string, error) {
+	if !IsOutputPathPattern(pattern) {
+		return pattern, nil
+	}
+
+	tpl, err := template.New("output").Option("missingkey=error").Parse(pattern)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidOutputPath, err)
+	}
+
+	var buf bytes.Buffer
+
+	if err = tpl.Execute(&buf, OutputPathData{Schema: schema, Profile: profile}); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidOutputPath, err)
+	}
+
+	return buf.String(), nil
+}
@@ -16,6 +16,22 @@ const (
 	OutputTypePdf OutputType = "pdf"
 	// OutputTypeHtml is a OutputType of type html.
 	OutputTypeHtml OutputType = "html"
+	// OutputTypeJson is a OutputType of type json.
+	OutputTypeJson OutputType = "json"
+	// OutputTypeDocx is a OutputType of type docx.
+	OutputTypeDocx OutputType = "docx"
+	// OutputTypeMd is a OutputType of type md.
+	OutputTypeMd OutputType = "md"
+	// OutputTypeTxt is a OutputType of type txt.
+	OutputTypeTxt OutputType = "txt"
+	// OutputTypeTex is a OutputType of type tex.
+	OutputTypeTex OutputType = "tex"
+	// OutputTypePng is a OutputType of type png.
+	OutputTypePng OutputType = "png"
+	// OutputTypeJpg is a OutputType of type jpg.
+	OutputTypeJpg OutputType = "jpg"
+	// OutputTypeWebp is a OutputType of type webp.
+	OutputTypeWebp OutputType = "webp"
 )
 
 var ErrInvalidOutputType = fmt.Errorf("not a valid OutputType, try [%s]", strings.Join(_OutputTypeNames, ", "))
@@ -23,6 +39,14 @@ var ErrInvalidOutputType = fmt.Errorf("not a valid OutputType, try [%s]", string
 var _OutputTypeNames = []string{
 	string(OutputTypePdf),
 	string(OutputTypeHtml),
+	string(OutputTypeJson),
+	string(OutputTypeDocx),
+	string(OutputTypeMd),
+	string(OutputTypeTxt),
+	string(OutputTypeTex),
+	string(OutputTypePng),
+	string(OutputTypeJpg),
+	string(OutputTypeWebp),
 }
 
 // OutputTypeNames returns a list of possible string values of OutputType.
@@ -47,6 +71,14 @@ func (x OutputType) IsValid() bool {
 var _OutputTypeValue = map[string]OutputType{
 	"pdf":  OutputTypePdf,
 	"html": OutputTypeHtml,
+	"json": OutputTypeJson,
+	"docx": OutputTypeDocx,
+	"md":   OutputTypeMd,
+	"txt":  OutputTypeTxt,
+	"tex":  OutputTypeTex,
+	"png":  OutputTypePng,
+	"jpg":  OutputTypeJpg,
+	"webp": OutputTypeWebp,
 }
 
 // ParseOutputType attempts to convert a string to a OutputType.
@@ -47,7 +47,7 @@ func TestDetectOutputType(t *testing.T) {
 		{
 			name: "detect unknown",
 			got: func() customString {
-				return types.DetectFileType[types.OutputType]("test.txt")
+				return types.DetectFileType[types.OutputType]("test.doc")
 			},
 			isValid: false,
 		},
@@ -61,7 +61,7 @@ func TestDetectOutputType(t *testing.T) {
 		{
 			name: "reverse multi extension",
 			got: func() customString {
-				return types.DetectFileType[types.OutputType]("x.pdf.txt")
+				return types.DetectFileType[types.OutputType]("x.pdf.doc")
 			},
 			isValid: false,
 		},
@@ -86,12 +86,21 @@ func TestDetectOutputType(t *testing.T) {
 			got: func() customString {
 				return types.DetectFileType[types.SchemaType]("path/to/test.json")
 			},
-			isValid: false,
+			isValid: true,
+			want:    types.SchemaTypeJson,
 		},
 		{
 			name: "toml",
 			got: func() customString {
-				return types.DetectFileType[types.SchemaType]("path/to/test.toml")
+				return types.DetectFileType[types.SchemaType]("path/to/test.TOML")
+			},
+			isValid: true,
+			want:    types.SchemaTypeToml,
+		},
+		{
+			name: "unknown schema type",
+			got: func() customString {
+				return types.DetectFileType[types.SchemaType]("path/to/test.ini")
 			},
 			isValid: false,
 		},
@@ -112,3 +121,65 @@ func TestDetectOutputType(t *testing.T) {
 		)
 	}
 }
+
+func TestRenderOutputPath(t *testing.T) {
+	t.Parallel()
+
+	schema := &types.Schema{Bio: types.SchemaBio{Name: "John Doe"}}
+
+	testCases := []struct {
+		name     string
+		pattern  string
+		profile  string
+		expected string
+		hasError bool
+	}{
+		{
+			name:     "plain path",
+			pattern:  "out/cv.pdf",
+			profile:  "backend",
+			expected: "out/cv.pdf",
+		},
+		{
+			name:     "profile pattern",
+			pattern:  "out/cv-{{.Profile}}.pdf",
+			profile:  "backend",
+			expected: "out/cv-backend.pdf",
+		},
+		{
+			name:     "schema fields",
+			pattern:  `{{.Bio.Name | printf "%s"}}-{{.Profile}}.html`,
+			profile:  "frontend",
+			expected: "John Doe-frontend.html",
+		},
+		{
+			name:     "invalid pattern",
+			pattern:  "cv-{{.Profile.pdf",
+			hasError: true,
+		},
+		{
+			name:     "unknown field",
+			pattern:  "cv-{{.Unknown}}.pdf",
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				got, err := types.RenderOutputPath(tc.pattern, schema, tc.profile)
+
+				if tc.hasError {
+					require.ErrorIs(t, err, types.ErrInvalidOutputPath)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			},
+		)
+	}
+}
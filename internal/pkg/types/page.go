@@ -1,21 +1,53 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 //go:generate go tool go-enum --nocase --names
 
 var ErrInvalidPageMargin = errors.New("invalid page margin")
 
 // PageSize is the type for defining the page size for the PDF.
-// ENUM(A4, B4, A, Arch-A, Letter).
+// ENUM(A4, B4, A, Arch-A, Letter, A3, A5, Legal, Tabloid).
 type PageSize string
 
+// PageOrientation is the type for defining the orientation of the pages of the PDF.
+// ENUM(portrait, landscape).
+type PageOrientation string
+
 // PageMargin is the type for defining the page margin for the PDF.
 type PageMargin struct {
-	Top    float64 `json:"top,omitempty"    validate:"gte=0,lt=3" yaml:"top"`
-	Right  float64 `json:"right,omitempty"  validate:"gte=0,lt=3" yaml:"right"`
-	Bottom float64 `json:"bottom,omitempty" validate:"gte=0,lt=3" yaml:"bottom"`
-	Left   float64 `json:"left,omitempty"   validate:"gte=0,lt=3" yaml:"left"`
+	Top    Length `json:"top,omitempty"    validate:"gte=0" yaml:"top"`
+	Right  Length `json:"right,omitempty"  validate:"gte=0" yaml:"right"`
+	Bottom Length `json:"bottom,omitempty" validate:"gte=0" yaml:"bottom"`
+	Left   Length `json:"left,omitempty"   validate:"gte=0" yaml:"left"`
+}
+
+// PageLayout is the size and the orientation of the page, which is resolved to the same
+// dimensions by every output (e.g. the printed PDF and the @page rule of the templates).
+type PageLayout struct {
+	Size        PageSize
+	Orientation PageOrientation
+
+	// Width and Height are the custom dimensions of the page, which take precedence over
+	// the size when both are provided.
+	Width  Length
+	Height Length
+}
+
+// PageHeaderFooter is the type for defining the header or the footer of the pages of the PDF.
+type PageHeaderFooter struct {
+	// Template is an HTML snippet rendered with the same data as the template,
+	// e.g. {{ .Schema.Bio.Name }}. The elements having the pageNumber and totalPages classes
+	// are filled with the page number and the number of pages, e.g.
+	// <span class="pageNumber"></span> of <span class="totalPages"></span>.
+	// The styles of the template do not apply to the snippet, so it must be styled inline.
+	Template string `json:"template" validate:"required" yaml:"template"`
+
+	// SkipFirstPage hides the header or the footer on the first page.
+	SkipFirstPage bool `json:"skipFirstPage,omitempty" yaml:"skipFirstPage"`
 }
 
 const (
@@ -30,6 +62,18 @@ const (
 
 	pageSizeArchAWidth  = 9
 	pageSizeArchAHeight = 12
+
+	pageSizeA3Width  = 11.69
+	pageSizeA3Height = 16.54
+
+	pageSizeA5Width  = 5.83
+	pageSizeA5Height = 8.27
+
+	pageSizeLegalWidth  = 8.5
+	pageSizeLegalHeight = 14
+
+	pageSizeTabloidWidth  = 11
+	pageSizeTabloidHeight = 17
 )
 
 // GetWidthInch returns the width of the page in inch.
@@ -43,6 +87,14 @@ func (p PageSize) GetWidthInch() float64 {
 		return pageSizeAWidth
 	case PageSizeArchA:
 		return pageSizeArchAWidth
+	case PageSizeA3:
+		return pageSizeA3Width
+	case PageSizeA5:
+		return pageSizeA5Width
+	case PageSizeLegal:
+		return pageSizeLegalWidth
+	case PageSizeTabloid:
+		return pageSizeTabloidWidth
 	}
 
 	return DefaultPageSize.GetWidthInch()
@@ -59,7 +111,92 @@ func (p PageSize) GetHeightInch() float64 {
 		return pageSizeAHeight
 	case PageSizeArchA:
 		return pageSizeArchAHeight
+	case PageSizeA3:
+		return pageSizeA3Height
+	case PageSizeA5:
+		return pageSizeA5Height
+	case PageSizeLegal:
+		return pageSizeLegalHeight
+	case PageSizeTabloid:
+		return pageSizeTabloidHeight
 	}
 
 	return DefaultPageSize.GetHeightInch()
 }
+
+// Validate checks that the layout has either a valid size, or both of the custom dimensions.
+func (l PageLayout) Validate() error {
+	if l.Orientation != "" && !l.Orientation.IsValid() {
+		return fmt.Errorf("%s is %w", l.Orientation, ErrInvalidPageOrientation)
+	}
+
+	if l.Width != 0 || l.Height != 0 {
+		if l.Width <= 0 || l.Height <= 0 {
+			return fmt.Errorf("%w: both of the custom width and height must be positive", ErrInvalidPageSize)
+		}
+
+		return nil
+	}
+
+	if !l.Size.IsValid() {
+		return ErrInvalidPageSize
+	}
+
+	return nil
+}
+
+// Dimensions returns the width and the height of the page. The landscape orientation swaps
+// the dimensions of the size, so the width is the longer one.
+func (l PageLayout) Dimensions() (Length, Length) {
+	width, height := Length(l.Size.GetWidthInch()), Length(l.Size.GetHeightInch())
+
+	if l.Width > 0 && l.Height > 0 {
+		width, height = l.Width, l.Height
+	}
+
+	if l.Orientation == PageOrientationLandscape {
+		width, height = max(width, height), min(width, height)
+	}
+
+	return width, height
+}
+
+// PageWidth returns the width of the page in inch, e.g. to limit the width of the content.
+func (l PageLayout) PageWidth() Length {
+	width, _ := l.Dimensions()
+
+	return width
+}
+
+// PageHeight returns the height of the page in inch.
+func (l PageLayout) PageHeight() Length {
+	_, height := l.Dimensions()
+
+	return height
+}
+
+// CSSSize returns the dimensions of the page for the size descriptor of the @page rule,
+// e.g. "8.27in 11.69in".
+func (l PageLayout) CSSSize() string {
+	width, height := l.Dimensions()
+
+	return width.CSS() + " " + height.CSS()
+}
+
+// CSS returns the margin for the margin property of the @page rule, e.g. "0.5in 0.5in 0.5in 0.5in".
+func (m PageMargin) CSS() string {
+	return m.Top.CSS() + " " + m.Right.CSS() + " " + m.Bottom.CSS() + " " + m.Left.CSS()
+}
+
+// Fits checks that the margin leaves room for the content on a page having the dimensions.
+func (m PageMargin) Fits(width, height Length) error {
+	if m.Left+m.Right >= width {
+		return fmt.Errorf("left and right margins (%s) exceed the page width (%s)", (m.Left + m.Right).CSS(), width.CSS())
+	}
+
+	if m.Top+m.Bottom >= height {
+		return fmt.Errorf("top and bottom margins (%s) exceed the page height (%s)", (m.Top + m.Bottom).CSS(), height.CSS())
+	}
+
+	return nil
+}
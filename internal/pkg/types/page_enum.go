@@ -11,6 +11,56 @@ import (
 	"strings"
 )
 
+const (
+	// PageOrientationPortrait is a PageOrientation of type portrait.
+	PageOrientationPortrait PageOrientation = "portrait"
+	// PageOrientationLandscape is a PageOrientation of type landscape.
+	PageOrientationLandscape PageOrientation = "landscape"
+)
+
+var ErrInvalidPageOrientation = fmt.Errorf("not a valid PageOrientation, try [%s]", strings.Join(_PageOrientationNames, ", "))
+
+var _PageOrientationNames = []string{
+	string(PageOrientationPortrait),
+	string(PageOrientationLandscape),
+}
+
+// PageOrientationNames returns a list of possible string values of PageOrientation.
+func PageOrientationNames() []string {
+	tmp := make([]string, len(_PageOrientationNames))
+	copy(tmp, _PageOrientationNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x PageOrientation) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x PageOrientation) IsValid() bool {
+	_, err := ParsePageOrientation(string(x))
+	return err == nil
+}
+
+var _PageOrientationValue = map[string]PageOrientation{
+	"portrait":  PageOrientationPortrait,
+	"landscape": PageOrientationLandscape,
+}
+
+// ParsePageOrientation attempts to convert a string to a PageOrientation.
+func ParsePageOrientation(name string) (PageOrientation, error) {
+	if x, ok := _PageOrientationValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _PageOrientationValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return PageOrientation(""), fmt.Errorf("%s is %w", name, ErrInvalidPageOrientation)
+}
+
 const (
 	// PageSizeA4 is a PageSize of type A4.
 	PageSizeA4 PageSize = "A4"
@@ -22,6 +72,14 @@ const (
 	PageSizeArchA PageSize = "Arch-A"
 	// PageSizeLetter is a PageSize of type Letter.
 	PageSizeLetter PageSize = "Letter"
+	// PageSizeA3 is a PageSize of type A3.
+	PageSizeA3 PageSize = "A3"
+	// PageSizeA5 is a PageSize of type A5.
+	PageSizeA5 PageSize = "A5"
+	// PageSizeLegal is a PageSize of type Legal.
+	PageSizeLegal PageSize = "Legal"
+	// PageSizeTabloid is a PageSize of type Tabloid.
+	PageSizeTabloid PageSize = "Tabloid"
 )
 
 var ErrInvalidPageSize = fmt.Errorf("not a valid PageSize, try [%s]", strings.Join(_PageSizeNames, ", "))
@@ -32,6 +90,10 @@ var _PageSizeNames = []string{
 	string(PageSizeA),
 	string(PageSizeArchA),
 	string(PageSizeLetter),
+	string(PageSizeA3),
+	string(PageSizeA5),
+	string(PageSizeLegal),
+	string(PageSizeTabloid),
 }
 
 // PageSizeNames returns a list of possible string values of PageSize.
@@ -54,16 +116,24 @@ func (x PageSize) IsValid() bool {
 }
 
 var _PageSizeValue = map[string]PageSize{
-	"A4":     PageSizeA4,
-	"a4":     PageSizeA4,
-	"B4":     PageSizeB4,
-	"b4":     PageSizeB4,
-	"A":      PageSizeA,
-	"a":      PageSizeA,
-	"Arch-A": PageSizeArchA,
-	"arch-a": PageSizeArchA,
-	"Letter": PageSizeLetter,
-	"letter": PageSizeLetter,
+	"A4":      PageSizeA4,
+	"a4":      PageSizeA4,
+	"B4":      PageSizeB4,
+	"b4":      PageSizeB4,
+	"A":       PageSizeA,
+	"a":       PageSizeA,
+	"Arch-A":  PageSizeArchA,
+	"arch-a":  PageSizeArchA,
+	"Letter":  PageSizeLetter,
+	"letter":  PageSizeLetter,
+	"A3":      PageSizeA3,
+	"a3":      PageSizeA3,
+	"A5":      PageSizeA5,
+	"a5":      PageSizeA5,
+	"Legal":   PageSizeLegal,
+	"legal":   PageSizeLegal,
+	"Tabloid": PageSizeTabloid,
+	"tabloid": PageSizeTabloid,
 }
 
 // ParsePageSize attempts to convert a string to a PageSize.
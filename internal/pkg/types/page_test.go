@@ -0,0 +1,196 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLength(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		text     string
+		expected types.Length
+		hasError bool
+	}{
+		{text: "0.5", expected: 0.5},
+		{text: "1in", expected: 1},
+		{text: "2.54cm", expected: 1},
+		{text: " 25.4 MM ", expected: 1},
+		{text: "36pt", expected: 0.5},
+		{text: ".5in", expected: 0.5},
+		{text: "-1in", hasError: true},
+		{text: "1px", hasError: true},
+		{text: "", hasError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.text, func(t *testing.T) {
+				t.Parallel()
+
+				length, err := types.ParseLength(tc.text)
+
+				if tc.hasError {
+					require.ErrorIs(t, err, types.ErrInvalidLength)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.InDelta(t, float64(tc.expected), float64(length), 1e-9)
+			},
+		)
+	}
+}
+
+func TestNewSchema_PageLengths(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		content     string
+		contentType types.SchemaType
+	}{
+		{
+			name:        "yaml",
+			content:     "page: {width: 210mm, height: 11, margin: {top: 0.5, left: 36pt}}",
+			contentType: types.SchemaTypeYaml,
+		},
+		{
+			name:        "json",
+			content:     `{"page": {"width": "210mm", "height": 11, "margin": {"top": 0.5, "left": "36pt"}}}`,
+			contentType: types.SchemaTypeJson,
+		},
+		{
+			name:        "toml",
+			content:     "[page]\nwidth = \"210mm\"\nheight = 11\n[page.margin]\ntop = 0.5\nleft = \"36pt\"",
+			contentType: types.SchemaTypeToml,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				data, err := types.NewSchema([]byte(tc.content), tc.contentType)
+
+				require.NoError(t, err)
+				require.InDelta(t, 8.2677, float64(data.Page.Width), 1e-4)
+				require.InDelta(t, 11, float64(data.Page.Height), 1e-9)
+				require.InDelta(t, 0.5, float64(data.Page.Margin.Top), 1e-9)
+				require.InDelta(t, 0.5, float64(data.Page.Margin.Left), 1e-9)
+				require.Equal(t, types.PageOrientationPortrait, data.Page.Orientation)
+			},
+		)
+	}
+}
+
+func TestPageLayout_Dimensions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		layout         types.PageLayout
+		expectedWidth  types.Length
+		expectedHeight types.Length
+		expectedCSS    string
+	}{
+		{
+			name:           "size",
+			layout:         types.PageLayout{Size: types.PageSizeLegal},
+			expectedWidth:  8.5,
+			expectedHeight: 14,
+			expectedCSS:    "8.5in 14in",
+		},
+		{
+			name:           "landscape",
+			layout:         types.PageLayout{Size: types.PageSizeA4, Orientation: types.PageOrientationLandscape},
+			expectedWidth:  11.69,
+			expectedHeight: 8.27,
+			expectedCSS:    "11.69in 8.27in",
+		},
+		{
+			name:           "custom",
+			layout:         types.PageLayout{Size: types.PageSizeA4, Width: 5, Height: 7},
+			expectedWidth:  5,
+			expectedHeight: 7,
+			expectedCSS:    "5in 7in",
+		},
+		{
+			name: "custom-landscape",
+			layout: types.PageLayout{
+				Size: types.PageSizeA4, Orientation: types.PageOrientationLandscape, Width: 5, Height: 7,
+			},
+			expectedWidth:  7,
+			expectedHeight: 5,
+			expectedCSS:    "7in 5in",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				require.NoError(t, tc.layout.Validate())
+
+				width, height := tc.layout.Dimensions()
+
+				require.Equal(t, tc.expectedWidth, width)
+				require.Equal(t, tc.expectedHeight, height)
+				require.Equal(t, tc.expectedCSS, tc.layout.CSSSize())
+			},
+		)
+	}
+}
+
+func TestPageLayout_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		layout types.PageLayout
+		err    error
+	}{
+		{
+			name:   "invalid-size",
+			layout: types.PageLayout{Size: "A0"},
+			err:    types.ErrInvalidPageSize,
+		},
+		{
+			name:   "width-without-height",
+			layout: types.PageLayout{Size: types.PageSizeA4, Width: 5},
+			err:    types.ErrInvalidPageSize,
+		},
+		{
+			name:   "invalid-orientation",
+			layout: types.PageLayout{Size: types.PageSizeA4, Orientation: "diagonal"},
+			err:    types.ErrInvalidPageOrientation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				require.ErrorIs(t, tc.layout.Validate(), tc.err)
+			},
+		)
+	}
+}
+
+func TestPageMargin(t *testing.T) {
+	t.Parallel()
+
+	margin := types.PageMargin{Top: 0.5, Right: 1, Bottom: 0.5, Left: 1}
+
+	require.Equal(t, "0.5in 1in 0.5in 1in", margin.CSS())
+	require.NoError(t, margin.Fits(8.27, 11.69))
+	require.Error(t, margin.Fits(2, 11.69))
+	require.Error(t, margin.Fits(8.27, 1))
+}
@@ -0,0 +1,228 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+//go:generate go tool go-enum --names
+
+var ErrProfileNotFound = errors.New("profile is not defined in the schema file")
+
+// SectionName is the name of a section of the CV that can be reordered.
+// ENUM(workExperiences, educations, certificates, publications, skills, projects, customSections).
+type SectionName string
+
+type SchemaProfileBio struct {
+	// Title replaces the career title of the person.
+	Title string `json:"title,omitempty" validate:"omitempty,min=2" yaml:"title"`
+
+	// About replaces the short description about the person.
+	About string `json:"about,omitempty" yaml:"about"`
+}
+
+type SchemaProfileHeaders struct {
+	WorkExperiences string `json:"workExperiences,omitempty" yaml:"workExperiences"`
+	Educations      string `json:"educations,omitempty"      yaml:"educations"`
+	Certificates    string `json:"certificates,omitempty"    yaml:"certificates"`
+	Publications    string `json:"publications,omitempty"    yaml:"publications"`
+	Skills          string `json:"skills,omitempty"          yaml:"skills"`
+	Projects        string `json:"projects,omitempty"        yaml:"projects"`
+}
+
+type SchemaProfileTemplate struct {
+	// Path replaces the path of the template file. Providing it ignores the template name.
+	Path string `json:"path,omitempty" yaml:"path"`
+
+	// Name replaces the template name in the Civic's template registry.
+	// Providing it ignores the template path.
+	Name string `json:"name,omitempty" yaml:"name"`
+
+	// Customizer replaces the customizations of the template in use.
+	Customizer *Customizer `json:"customizer,omitempty" yaml:"customizer"`
+}
+
+type SchemaProfilePage struct {
+	// Size replaces the size of the page for the PDF.
+	Size PageSize `json:"size,omitempty" validate:"omitempty,enum" yaml:"size"`
+
+	// Orientation replaces the orientation of the page for the PDF.
+	Orientation PageOrientation `json:"orientation,omitempty" validate:"omitempty,enum" yaml:"orientation"`
+
+	// Width replaces the custom width of the page, along with the height.
+	Width Length `json:"width,omitempty" validate:"required_with=Height,gte=0" yaml:"width"`
+
+	// Height replaces the custom height of the page, along with the width.
+	Height Length `json:"height,omitempty" validate:"required_with=Width,gte=0" yaml:"height"`
+
+	// MaxPages replaces the maximum number of pages of the PDF.
+	MaxPages int `json:"maxPages,omitempty" validate:"gte=0" yaml:"maxPages"`
+
+	// Margin replaces the margin of the page for the PDF.
+	Margin *PageMargin `json:"margin,omitempty" validate:"omitempty" yaml:"margin"`
+}
+
+// SchemaProfile is an overlay on top of the schema to generate a variant of the CV
+// (e.g. for a specific job family) from the same schema file.
+// Any value that is not provided in the profile is kept as is.
+type SchemaProfile struct {
+	Bio *SchemaProfileBio `json:"bio,omitempty" validate:"omitempty" yaml:"bio"`
+
+	// Headers replace the printed header/title of the sections.
+	Headers *SchemaProfileHeaders `json:"headers,omitempty" yaml:"headers"`
+
+	// Order replaces the order of the sections in the CV.
+	Order []SectionName `json:"order,omitempty" validate:"omitempty,unique,dive,enum" yaml:"order"`
+
+	Template *SchemaProfileTemplate `json:"template,omitempty" yaml:"template"`
+
+	Page *SchemaProfilePage `json:"page,omitempty" validate:"omitempty" yaml:"page"`
+}
+
+// DefaultSectionOrder is the order of the sections when it is not defined in the schema.
+func DefaultSectionOrder() []SectionName {
+	return []SectionName{
+		SectionNameWorkExperiences,
+		SectionNameEducations,
+		SectionNameCertificates,
+		SectionNamePublications,
+		SectionNameSkills,
+		SectionNameProjects,
+		SectionNameCustomSections,
+	}
+}
+
+// ProfileNames returns the sorted names of the profiles defined in the schema.
+func (s *Schema) ProfileNames() []string {
+	return slices.Sorted(maps.Keys(s.Profiles))
+}
+
+// ApplyProfile overlays the profile with the provided name on top of the schema.
+func (s *Schema) ApplyProfile(name string) error {
+	profile, ok := s.Profiles[name]
+	if !ok {
+		return fmt.Errorf("%w: %s (defined profiles: %v)", ErrProfileNotFound, name, s.ProfileNames())
+	}
+
+	if profile.Bio != nil {
+		s.Bio.Title = valueOr(profile.Bio.Title, s.Bio.Title)
+		s.Bio.About = valueOr(profile.Bio.About, s.Bio.About)
+	}
+
+	if profile.Headers != nil {
+		s.applyProfileHeaders(*profile.Headers)
+	}
+
+	if len(profile.Order) > 0 {
+		s.Order = profile.Order
+	}
+
+	if profile.Template != nil {
+		if profile.Template.Path != "" || profile.Template.Name != "" {
+			s.Template.Path = profile.Template.Path
+			s.Template.Name = profile.Template.Name
+		}
+
+		if profile.Template.Customizer != nil {
+			s.Template.Customizer = *profile.Template.Customizer
+		}
+	}
+
+	if profile.Page != nil {
+		s.Page.Size = valueOr(profile.Page.Size, s.Page.Size)
+		s.Page.Orientation = valueOr(profile.Page.Orientation, s.Page.Orientation)
+		s.Page.MaxPages = valueOr(profile.Page.MaxPages, s.Page.MaxPages)
+
+		// The custom dimensions take precedence over the size, so a profile replacing
+		// the size also drops the custom dimensions of the schema.
+		if profile.Page.Size != "" || profile.Page.Width != 0 || profile.Page.Height != 0 {
+			s.Page.Width = profile.Page.Width
+			s.Page.Height = profile.Page.Height
+		}
+
+		if profile.Page.Margin != nil {
+			s.Page.Margin = *profile.Page.Margin
+		}
+	}
+
+	return nil
+}
+
+// Sections returns the name of the sections having any content in the order they should be rendered.
+// Templates can range over this list to respect the section order defined in the schema.
+func (s *Schema) Sections() []SectionName {
+	order := s.Order
+	if len(order) == 0 {
+		order = DefaultSectionOrder()
+	}
+
+	sections := make([]SectionName, 0, len(order))
+
+	for _, section := range order {
+		if s.hasSection(section) {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+func (s *Schema) hasSection(section SectionName) bool {
+	switch section {
+	case SectionNameWorkExperiences:
+		return s.WorkExperiences != nil
+	case SectionNameEducations:
+		return s.Educations != nil
+	case SectionNameCertificates:
+		return s.Certificates != nil
+	case SectionNamePublications:
+		return s.Publications != nil
+	case SectionNameSkills:
+		return s.Skills != nil
+	case SectionNameProjects:
+		return s.Projects != nil
+	case SectionNameCustomSections:
+		return len(s.CustomSections) > 0
+	}
+
+	return false
+}
+
+func (s *Schema) applyProfileHeaders(headers SchemaProfileHeaders) {
+	if s.WorkExperiences != nil {
+		s.WorkExperiences.Header = valueOr(headers.WorkExperiences, s.WorkExperiences.Header)
+	}
+
+	if s.Educations != nil {
+		s.Educations.Header = valueOr(headers.Educations, s.Educations.Header)
+	}
+
+	if s.Certificates != nil {
+		s.Certificates.Header = valueOr(headers.Certificates, s.Certificates.Header)
+	}
+
+	if s.Publications != nil {
+		s.Publications.Header = valueOr(headers.Publications, s.Publications.Header)
+	}
+
+	if s.Skills != nil {
+		s.Skills.Header = valueOr(headers.Skills, s.Skills.Header)
+	}
+
+	if s.Projects != nil {
+		s.Projects.Header = valueOr(headers.Projects, s.Projects.Header)
+	}
+}
+
+// valueOr returns the value if it is not empty, otherwise the fallback.
+func valueOr[T comparable](value T, fallback T) T {
+	var zero T
+
+	if value == zero {
+		return fallback
+	}
+
+	return value
+}
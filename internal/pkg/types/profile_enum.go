@@ -0,0 +1,78 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// SectionNameWorkExperiences is a SectionName of type workExperiences.
+	SectionNameWorkExperiences SectionName = "workExperiences"
+	// SectionNameEducations is a SectionName of type educations.
+	SectionNameEducations SectionName = "educations"
+	// SectionNameCertificates is a SectionName of type certificates.
+	SectionNameCertificates SectionName = "certificates"
+	// SectionNamePublications is a SectionName of type publications.
+	SectionNamePublications SectionName = "publications"
+	// SectionNameSkills is a SectionName of type skills.
+	SectionNameSkills SectionName = "skills"
+	// SectionNameProjects is a SectionName of type projects.
+	SectionNameProjects SectionName = "projects"
+	// SectionNameCustomSections is a SectionName of type customSections.
+	SectionNameCustomSections SectionName = "customSections"
+)
+
+var ErrInvalidSectionName = fmt.Errorf("not a valid SectionName, try [%s]", strings.Join(_SectionNameNames, ", "))
+
+var _SectionNameNames = []string{
+	string(SectionNameWorkExperiences),
+	string(SectionNameEducations),
+	string(SectionNameCertificates),
+	string(SectionNamePublications),
+	string(SectionNameSkills),
+	string(SectionNameProjects),
+	string(SectionNameCustomSections),
+}
+
+// SectionNameNames returns a list of possible string values of SectionName.
+func SectionNameNames() []string {
+	tmp := make([]string, len(_SectionNameNames))
+	copy(tmp, _SectionNameNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x SectionName) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SectionName) IsValid() bool {
+	_, err := ParseSectionName(string(x))
+	return err == nil
+}
+
+var _SectionNameValue = map[string]SectionName{
+	"workExperiences": SectionNameWorkExperiences,
+	"educations":      SectionNameEducations,
+	"certificates":    SectionNameCertificates,
+	"publications":    SectionNamePublications,
+	"skills":          SectionNameSkills,
+	"projects":        SectionNameProjects,
+	"customSections":  SectionNameCustomSections,
+}
+
+// ParseSectionName attempts to convert a string to a SectionName.
+func ParseSectionName(name string) (SectionName, error) {
+	if x, ok := _SectionNameValue[name]; ok {
+		return x, nil
+	}
+	return SectionName(""), fmt.Errorf("%s is %w", name, ErrInvalidSectionName)
+}
@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+const profileTestSchema = `template: {path: "path", customizer: {style: "body {}"}}
+page: {size: "Letter", margin: {top: 1}}
+bio: {name: "John Doe", title: "Engineer", about: "General about"}
+workExperiences:
+  entities: [{title: "Engineer", company: "Acme", startDate: "2020"}]
+skills:
+  entities: [{category: "Backend", items: [{name: "Go"}]}]
+profiles:
+  backend:
+    bio: {title: "Backend Engineer"}
+    headers: {skills: "Backend Skills", projects: "Ignored"}
+    order: [skills, workExperiences, projects]
+    template: {name: "genesis"}
+    page: {margin: {left: 0.5}}
+  minimal: {}
+`
+
+func TestSchema_ApplyProfile(t *testing.T) {
+	t.Parallel()
+
+	data, err := types.NewSchema([]byte(profileTestSchema), types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "minimal"}, data.ProfileNames())
+	require.Equal(t, []types.SectionName{types.SectionNameWorkExperiences, types.SectionNameSkills}, data.Sections())
+
+	require.ErrorIs(t, data.ApplyProfile("unknown"), types.ErrProfileNotFound)
+	require.NoError(t, data.ApplyProfile("backend"))
+	require.NoError(t, data.IsValid())
+
+	require.Equal(t, "Backend Engineer", data.Bio.Title)
+	require.Equal(t, "General about", data.Bio.About)
+	require.Equal(t, "Work Experiences", data.WorkExperiences.Header)
+	require.Equal(t, "Backend Skills", data.Skills.Header)
+	require.Nil(t, data.Projects)
+	require.Equal(t, []types.SectionName{types.SectionNameSkills, types.SectionNameWorkExperiences}, data.Sections())
+
+	require.Empty(t, data.Template.Path)
+	require.Equal(t, "genesis", data.Template.Name)
+	require.Equal(t, "body {}", data.Template.Customizer.Style)
+	require.Equal(t, types.PageSizeLetter, data.Page.Size)
+	require.Equal(t, types.PageMargin{Left: 0.5}, data.Page.Margin)
+}
+
+func TestSchema_ApplyProfile_PageLayout(t *testing.T) {
+	t.Parallel()
+
+	content := `page: {width: 7in, height: 10in}
+profiles:
+  print: {page: {size: "A3", orientation: "landscape"}}
+`
+
+	data, err := types.NewSchema([]byte(content), types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+	require.Equal(t, "7in 10in", data.Page.Layout().CSSSize())
+
+	require.NoError(t, data.ApplyProfile("print"))
+	require.Equal(t, "16.54in 11.69in", data.Page.Layout().CSSSize())
+}
+
+func TestSchema_ApplyProfile_Empty(t *testing.T) {
+	t.Parallel()
+
+	data, err := types.NewSchema([]byte(profileTestSchema), types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+
+	original, err := types.NewSchema([]byte(profileTestSchema), types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+	require.NoError(t, data.ApplyProfile("minimal"))
+	require.Equal(t, original, data)
+}
+
+func TestSchema_InvalidProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		extra string
+		field string
+		tag   string
+	}{
+		{
+			name:  "unknown section in order",
+			extra: "order: [skills, hobbies]",
+			field: "Schema.Order[1]",
+			tag:   "enum",
+		},
+		{
+			name:  "duplicate section in profile order",
+			extra: "profiles: {backend: {order: [skills, skills]}}",
+			field: "Schema.Profiles[backend].Order",
+			tag:   "unique",
+		},
+		{
+			name:  "invalid page size in profile",
+			extra: "profiles: {backend: {page: {size: A0}}}",
+			field: "Schema.Profiles[backend].Page.Size",
+			tag:   "enum",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				content := "template: {path: path}\nbio: {name: John Doe, title: Engineer}\n" + tc.extra
+
+				data, err := types.NewSchema([]byte(content), types.SchemaTypeYaml)
+
+				require.NoError(t, err)
+
+				ve := validator.ValidationErrors{}
+
+				require.ErrorAs(t, data.IsValid(), &ve)
+				require.Len(t, ve, 1)
+				require.Equal(t, tc.field, ve[0].StructNamespace())
+				require.Equal(t, tc.tag, ve[0].Tag())
+			},
+		)
+	}
+}
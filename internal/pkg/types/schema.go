@@ -1,8 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"time"
 
+	"github.com/BurntSushi/toml"
 	"github.com/creasty/defaults"
 	"github.com/go-playground/validator/v10"
 	"gopkg.in/yaml.v3"
@@ -13,9 +17,17 @@ var ErrEmptySchemaPath = errors.New("schema file path is empty")
 //go:generate go tool go-enum --names
 
 // SchemaType is the type of schema being used to generate the CV.
-// ENUM(yaml, yml).
+// ENUM(yaml, yml, json, toml).
 type SchemaType string
 
+// ImportFormat is the format of a resume that can be imported as a schema file.
+// ENUM(jsonresume, linkedin).
+type ImportFormat string
+
+// PublicationSort is the order of the publications loaded from a bibliography source.
+// ENUM(none, newest, oldest, title).
+type PublicationSort string
+
 type Customizer struct {
 	// Style is a block of css code that will be added in a style tag
 	// at the end of the HEAD section of the template.
@@ -35,18 +47,70 @@ type SchemaTemplate struct {
 
 	// Customizer is a way for you to customize the template in use.
 	Customizer Customizer `json:"customizer,omitempty" yaml:"customizer"`
+
+	// LaTeX is the template used for the LaTeX (tex) output, as the HTML templates
+	// cannot be rendered as LaTeX documents.
+	LaTeX SchemaLaTeXTemplate `json:"latex,omitempty" yaml:"latex"`
+}
+
+type SchemaLaTeXTemplate struct {
+	// Path is the local path or HTTP link to the LaTeX template file.
+	// If provided, it takes precedence over the name.
+	Path string `json:"path,omitempty" yaml:"path"`
+
+	// Name is the LaTeX template name in the Civic's template registry.
+	// Default is moderncv.
+	Name string `json:"name,omitempty" yaml:"name"`
 }
 
 type SchemaPage struct {
 	// Size is the size of the page for the PDF.
-	// Valid values are: A4, B4, A, Arch-A, Letter.
+	// Valid values are: A4, B4, A, Arch-A, Letter, A3, A5, Legal, Tabloid.
 	// If an invalid value is provided, it will default to A4.
 	Size PageSize `default:"A4" json:"size,omitempty" yaml:"size"`
 
+	// Orientation is the orientation of the page for the PDF.
+	// Valid values are: portrait, landscape. Default is portrait.
+	Orientation PageOrientation `default:"portrait" json:"orientation,omitempty" validate:"omitempty,enum" yaml:"orientation"`
+
+	// Width is the custom width of the page, which replaces the size along with the height.
+	// It is either a number in inch, or a text with the in, cm, mm, or pt unit (e.g. "210mm").
+	Width Length `json:"width,omitempty" validate:"required_with=Height,gte=0" yaml:"width"`
+
+	// Height is the custom height of the page, which replaces the size along with the width.
+	// It is either a number in inch, or a text with the in, cm, mm, or pt unit (e.g. "297mm").
+	Height Length `json:"height,omitempty" validate:"required_with=Width,gte=0" yaml:"height"`
+
 	// Margin is the margin of the page for the PDF.
 	// Absence of margin for each side leads to 0.
-	// IMPORTANT: Dimensions are in inch.
+	// Each side is either a number in inch, or a text with the in, cm, mm, or pt unit (e.g. "12mm").
 	Margin PageMargin `json:"margin,omitempty" yaml:"margin"`
+
+	// MaxPages is the maximum number of pages of the PDF. The content is scaled down until it
+	// fits in these pages, and generating the PDF fails if it does not fit at the minimum scale.
+	// Absence of it, or 0, leaves the content unscaled.
+	MaxPages int `json:"maxPages,omitempty" validate:"gte=0" yaml:"maxPages"`
+
+	// MinScale is the minimum scale of the content when fitting it in the maximum number of pages.
+	// Valid values are between 0.1 and 1. Default is 0.7.
+	MinScale float64 `json:"minScale,omitempty" validate:"omitempty,gte=0.1,lte=1" yaml:"minScale"`
+
+	// Header is printed at the top of each page of the PDF, within the top margin.
+	Header *PageHeaderFooter `json:"header,omitempty" validate:"omitempty" yaml:"header"`
+
+	// Footer is printed at the bottom of each page of the PDF, within the bottom margin.
+	Footer *PageHeaderFooter `json:"footer,omitempty" validate:"omitempty" yaml:"footer"`
+}
+
+// Layout returns the size and the orientation of the page. Templates can use it to style
+// the page consistently with the PDF, e.g. {{.Schema.Page.Layout.CSSSize}}.
+func (p SchemaPage) Layout() PageLayout {
+	return PageLayout{
+		Size:        p.Size,
+		Orientation: p.Orientation,
+		Width:       p.Width,
+		Height:      p.Height,
+	}
 }
 
 type SchemaBioContact struct {
@@ -104,18 +168,23 @@ type SchemaWorkExperienceEntity struct {
 	// Location is the location of the job.
 	Location string `json:"location,omitempty" yaml:"location"`
 
-	// StartDate is the start date of the job. There is no validation for the date format.
-	StartDate string `json:"startDate" validate:"required" yaml:"startDate"`
+	// StartDate is the start date of the job (YYYY, YYYY-MM, or YYYY-MM-DD).
+	StartDate Date `json:"startDate" validate:"required,date" yaml:"startDate"`
 
-	// EndDate is the end date of the job. There is no validation for the date format.
-	EndDate string `default:"present" json:"endDate,omitempty" yaml:"endDate"`
+	// EndDate is the end date of the job (YYYY, YYYY-MM, YYYY-MM-DD, or present).
+	// It cannot be before the start date.
+	EndDate Date `default:"present" json:"endDate,omitempty" validate:"omitempty,date,dateafter=StartDate" yaml:"endDate"`
 
 	// Details is the list of details about the job. There is no validation.
 	// It can include the list of achievements, responsibilities, and any other details.
-	Details []string `json:"details,omitempty" validate:"dive,min=2" yaml:"details"`
+	Details []SchemaDetail `json:"details,omitempty" validate:"dive,min=2" yaml:"details"`
 
 	// Technologies are the list of tools and technologies that you were exposed to during the job.
 	Technologies []string `json:"technologies,omitempty" validate:"dive,min=1" yaml:"technologies"`
+
+	// Tags are the arbitrary labels of this entity used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" validate:"dive,min=1" yaml:"tags"`
 }
 
 type SchemaWorkExperiences struct {
@@ -138,18 +207,23 @@ type SchemaEducationsEntity struct {
 	// Location is the location of the university or place of study.
 	Location string `json:"location,omitempty" yaml:"location"`
 
-	// StartDate is the start date of the study. There is no validation for the date format.
-	StartDate string `json:"startDate" validate:"required" yaml:"startDate"`
+	// StartDate is the start date of the study (YYYY, YYYY-MM, or YYYY-MM-DD).
+	StartDate Date `json:"startDate" validate:"required,date" yaml:"startDate"`
 
-	// EndDate is the end date of the study. There is no validation for the date format.
-	EndDate string `default:"present" json:"endDate,omitempty" yaml:"endDate"`
+	// EndDate is the end date of the study (YYYY, YYYY-MM, YYYY-MM-DD, or present).
+	// It cannot be before the start date.
+	EndDate Date `default:"present" json:"endDate,omitempty" validate:"omitempty,date,dateafter=StartDate" yaml:"endDate"`
 
 	// Details is the list of details about the study. There is no validation.
 	// It can include the list of achievements, responsibilities, and any other details.
-	Details []string `json:"details,omitempty" validate:"dive,min=2" yaml:"details"`
+	Details []SchemaDetail `json:"details,omitempty" validate:"dive,min=2" yaml:"details"`
 
 	// Technologies are the list of tools and technologies that you were exposed to during the study.
 	Technologies []string `json:"technologies,omitempty" validate:"dive,min=1" yaml:"technologies"`
+
+	// Tags are the arbitrary labels of this entity used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" validate:"dive,min=1" yaml:"tags"`
 }
 
 type SchemaEducations struct {
@@ -166,11 +240,16 @@ type SchemaCertificatesEntity struct {
 	// Issuer is the name of the issuer of the certificate.
 	Issuer string `json:"issuer,omitempty" yaml:"issuer"`
 
-	// IssueDate is the date when the certificate was issued. There is no validation for the date format.
-	IssueDate string `json:"issueDate,omitempty" yaml:"issueDate"`
+	// IssueDate is the date when the certificate was issued (YYYY, YYYY-MM, or YYYY-MM-DD).
+	IssueDate Date `json:"issueDate,omitempty" validate:"omitempty,date" yaml:"issueDate"`
 
-	// ExpiryDate is the date when the certificate will expire. There is no validation for the date format.
-	ExpirationDate string `json:"expirationDate,omitempty" yaml:"expirationDate"`
+	// ExpiryDate is the date when the certificate will expire (YYYY, YYYY-MM, or YYYY-MM-DD).
+	// It cannot be before the issue date.
+	ExpirationDate Date `json:"expirationDate,omitempty" validate:"omitempty,date,dateafter=IssueDate" yaml:"expirationDate"`
+
+	// Tags are the arbitrary labels of this entity used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" validate:"dive,min=1" yaml:"tags"`
 }
 
 type SchemaCertificates struct {
@@ -187,21 +266,55 @@ type SchemaPublicationsEntity struct {
 	// Publisher is the name of the publisher of the publication.
 	Publisher string `json:"publisher" validate:"required" yaml:"publisher"`
 
-	// PublishDate is the date when the publication was published. There is no validation for the date format.
-	PublishDate string `json:"publishDate" validate:"required" yaml:"publishDate"`
+	// PublishDate is the date when the publication was published (YYYY, YYYY-MM, or YYYY-MM-DD).
+	PublishDate Date `json:"publishDate" validate:"required,date" yaml:"publishDate"`
 
 	// Link is the link to the publication.
 	Link string `json:"link" validate:"required,url" yaml:"link"`
 
 	// Details is the list of details about the publication. There is no validation.
-	Details []string `json:"details,omitempty" validate:"dive,min=2" yaml:"details"`
+	Details []SchemaDetail `json:"details,omitempty" validate:"dive,min=2" yaml:"details"`
+
+	// Authors are the optional names of the authors of the publication.
+	Authors []string `json:"authors,omitempty" validate:"dive,min=1" yaml:"authors"`
+
+	// Tags are the arbitrary labels of this entity used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" validate:"dive,min=1" yaml:"tags"`
+}
+
+// SchemaPublicationsFilter selects the entries of the bibliography source.
+// An entry is kept if it matches all the provided criteria.
+type SchemaPublicationsFilter struct {
+	// Keys are the citation keys (BibTeX) or ids (CSL-JSON) of the entries to keep.
+	Keys []string `json:"keys,omitempty" validate:"dive,min=1" yaml:"keys"`
+
+	// FromYear keeps the entries published in or after this year.
+	FromYear int `json:"fromYear,omitempty" validate:"gte=0" yaml:"fromYear"`
+
+	// ToYear keeps the entries published in or before this year.
+	ToYear int `json:"toYear,omitempty" validate:"gte=0" yaml:"toYear"`
 }
 
 type SchemaPublications struct {
 	// Header is the printed header/title of this section.
 	Header string `default:"Publications" json:"header,omitempty" yaml:"header"`
 
-	Entities []SchemaPublicationsEntity `json:"entities" validate:"required,min=1,dive" yaml:"entities"`
+	// Source is the local path or link to a bibliography file (BibTeX .bib or CSL-JSON .json).
+	// Relative paths are resolved against the schema file declaring it. Its entries are added after
+	// the entities.
+	Source string `json:"source,omitempty" yaml:"source"`
+
+	// Filter selects the entries of the source. All the entries are used if it is not provided.
+	Filter *SchemaPublicationsFilter `json:"filter,omitempty" validate:"omitempty" yaml:"filter"`
+
+	// Sort is the order of the entries of the source.
+	// "none" keeps the order of the bibliography file.
+	Sort PublicationSort `default:"none" json:"sort,omitempty" validate:"omitempty,enum" yaml:"sort"`
+
+	// Entities are the publications. It can be omitted if the source is provided, in which case
+	// the section is left out when the filter selects no entry of the source.
+	Entities []SchemaPublicationsEntity `json:"entities,omitempty" validate:"required_without=Source,omitempty,min=1,dive" yaml:"entities"`
 }
 
 type SchemaSkillsEntityItem struct {
@@ -211,6 +324,10 @@ type SchemaSkillsEntityItem struct {
 	// Level is an optional gauge value between 1 and 5 to assess your
 	// proficiency in the given skill. 0 means no level is specified.
 	Level uint8 `json:"level,omitempty" validate:"min=0,max=5" yaml:"level"`
+
+	// Tags are the arbitrary labels of this entity used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" validate:"dive,min=1" yaml:"tags"`
 }
 
 type SchemaSkillsEntity struct {
@@ -236,7 +353,11 @@ type SchemaProjectsEntity struct {
 	Link string `json:"link" validate:"required,url" yaml:"link"`
 
 	// Details is the list of details about the project.
-	Details []string `json:"details,omitempty" validate:"dive,min=1" yaml:"details"`
+	Details []SchemaDetail `json:"details,omitempty" validate:"dive,min=1" yaml:"details"`
+
+	// Tags are the arbitrary labels of this entity used to include or exclude it
+	// when generating tailored variants of the CV.
+	Tags []string `json:"tags,omitempty" validate:"dive,min=1" yaml:"tags"`
 }
 
 type SchemaProjects struct {
@@ -251,12 +372,22 @@ type SchemaCustomSection struct {
 	Header string `json:"header" validate:"required,min=1" yaml:"header"`
 
 	// A list of arbitrary details to be shown under this section.
-	Details []string `json:"details" validate:"required,min=1,dive,min=2" yaml:"details"`
+	Details []SchemaDetail `json:"details" validate:"required,min=1,dive,min=2" yaml:"details"`
 }
 
 // Schema is the architecture of the configuration file that will be provided
 // by the user to be used for generating the final resume or cv.
 type Schema struct {
+	// Extends is the local path or link to a base schema file. This file is deep-merged on top
+	// of the base schema, so it only needs to define what differs from the base.
+	// Relative paths are resolved against the location of the file defining them.
+	Extends string `json:"extends,omitempty" yaml:"extends"`
+
+	// Include is the list of local paths or links to schema fragments. A fragment is a partial
+	// schema file (e.g. only containing the skills section) that is deep-merged into this file.
+	// Relative paths are resolved against the location of the file defining them.
+	Include []string `json:"include,omitempty" yaml:"include"`
+
 	// Template contains all the information related to the template file
 	// that will be used to create the resume or cv.
 	Template SchemaTemplate `json:"template,omitempty" validate:"required" yaml:"template"`
@@ -286,6 +417,14 @@ type Schema struct {
 
 	// CustomSections contains all the custom sections that you want to add to the resume or cv.
 	CustomSections []SchemaCustomSection `json:"customSections,omitempty" validate:"omitempty,dive" yaml:"customSections"`
+
+	// Order is the order of the sections in the resume or cv. Sections that are not listed
+	// are not rendered. If it is not provided, the template's default order is used.
+	Order []SectionName `json:"order,omitempty" validate:"omitempty,unique,dive,enum" yaml:"order"`
+
+	// Profiles are the named variants of the resume or cv. Each profile is an overlay
+	// that is applied on top of this schema when the profile is selected.
+	Profiles map[string]SchemaProfile `json:"profiles,omitempty" validate:"omitempty,dive,keys,min=1,endkeys,omitempty" yaml:"profiles"`
 }
 
 func NewSchema(content []byte, contentType SchemaType) (*Schema, error) {
@@ -297,6 +436,23 @@ func NewSchema(content []byte, contentType SchemaType) (*Schema, error) {
 			return nil, err
 		}
 
+	case SchemaTypeJson:
+		// Similar to YAML, an empty document is a valid (but empty) schema.
+		if len(bytes.TrimSpace(content)) == 0 {
+			break
+		}
+
+		if err := json.Unmarshal(content, &data); err != nil {
+			return nil, err
+		}
+
+	case SchemaTypeToml:
+		// TOML keys are matched case-insensitively against the field names,
+		// so the same keys used in YAML and JSON files are valid here.
+		if _, err := toml.Decode(string(content), &data); err != nil {
+			return nil, err
+		}
+
 	default:
 		return nil, ErrInvalidSchemaType
 	}
@@ -310,6 +466,173 @@ func NewSchema(content []byte, contentType SchemaType) (*Schema, error) {
 	return &data, nil
 }
 
+// UnmarshalDocument decodes the content of a schema file into a generic document.
+// Unlike NewSchema, it neither requires the content to follow the schema nor sets defaults.
+func UnmarshalDocument(content []byte, contentType SchemaType) (map[string]any, error) {
+	document := make(map[string]any)
+
+	switch contentType {
+	case SchemaTypeYaml, SchemaTypeYml:
+		if err := yaml.Unmarshal(content, &document); err != nil {
+			return nil, err
+		}
+
+	case SchemaTypeJson:
+		if len(bytes.TrimSpace(content)) == 0 {
+			break
+		}
+
+		if err := json.Unmarshal(content, &document); err != nil {
+			return nil, err
+		}
+
+	case SchemaTypeToml:
+		if err := toml.Unmarshal(content, &document); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, ErrInvalidSchemaType
+	}
+
+	normalizeDocument(document)
+
+	return document, nil
+}
+
+// MarshalDocument encodes a generic document into the content of a schema file
+// in the provided format.
+func MarshalDocument(document map[string]any, contentType SchemaType) ([]byte, error) {
+	switch contentType {
+	case SchemaTypeYaml, SchemaTypeYml:
+		var buf bytes.Buffer
+
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2) //nolint:mnd
+
+		if err := encoder.Encode(document); err != nil {
+			return nil, err
+		}
+
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+
+	case SchemaTypeJson:
+		return json.MarshalIndent(document, "", "  ")
+
+	case SchemaTypeToml:
+		var buf bytes.Buffer
+
+		if err := toml.NewEncoder(&buf).Encode(document); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return nil, ErrInvalidSchemaType
+}
+
+// EncodeSchema encodes the schema into the content of a schema file in the provided format.
+// Empty values are omitted, so the defaults are applied when the content is loaded again.
+func EncodeSchema(schema *Schema, contentType SchemaType) ([]byte, error) {
+	content, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := UnmarshalDocument(content, SchemaTypeJson)
+	if err != nil {
+		return nil, err
+	}
+
+	pruneDocument(document)
+
+	return MarshalDocument(document, contentType)
+}
+
+// pruneDocument removes the null values and empty objects of the document recursively.
+func pruneDocument(document map[string]any) {
+	for key, value := range document {
+		if value == nil {
+			delete(document, key)
+
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			pruneDocument(nested)
+
+			if len(nested) == 0 {
+				delete(document, key)
+			}
+		}
+	}
+}
+
+// normalizeDocument unifies the values produced by different decoders. Date and time values
+// detected by the YAML and TOML decoders are converted back to strings, as the schema does not
+// expect any typed date values, and TOML arrays of tables are converted to generic lists.
+func normalizeDocument(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeDocument(item)
+		}
+
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeDocument(item)
+		}
+
+	case []map[string]any:
+		list := make([]any, 0, len(v))
+
+		for _, item := range v {
+			list = append(list, normalizeDocument(item))
+		}
+
+		return list
+
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			return v.Format(time.DateOnly)
+		}
+
+		return v.Format(time.RFC3339)
+	}
+
+	return value
+}
+
 func (s *Schema) IsValid() error {
-	return validator.New(validator.WithRequiredStructEnabled()).Struct(s)
+	return newValidator().Struct(s)
+}
+
+// IsValidEntity validates a single entity of a section, so importers can leave out
+// the entries that would fail the validation of the whole schema.
+func IsValidEntity(entity any) error {
+	return newValidator().Struct(entity)
+}
+
+// isValidEnum validates the fields having an enum type generated by go-enum.
+func isValidEnum(fl validator.FieldLevel) bool {
+	enum, ok := fl.Field().Interface().(interface{ IsValid() bool })
+
+	return ok && enum.IsValid()
+}
+
+func newValidator() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	validate.RegisterCustomTypeFunc(detailText, SchemaDetail{})
+
+	_ = validate.RegisterValidation("enum", isValidEnum)
+	_ = validate.RegisterValidation("date", isValidDate)
+	_ = validate.RegisterValidation("dateafter", isDateAfter)
+
+	return validate
 }
@@ -11,11 +11,115 @@ import (
 	"strings"
 )
 
+const (
+	// ImportFormatJsonresume is a ImportFormat of type jsonresume.
+	ImportFormatJsonresume ImportFormat = "jsonresume"
+	// ImportFormatLinkedin is a ImportFormat of type linkedin.
+	ImportFormatLinkedin ImportFormat = "linkedin"
+)
+
+var ErrInvalidImportFormat = fmt.Errorf("not a valid ImportFormat, try [%s]", strings.Join(_ImportFormatNames, ", "))
+
+var _ImportFormatNames = []string{
+	string(ImportFormatJsonresume),
+	string(ImportFormatLinkedin),
+}
+
+// ImportFormatNames returns a list of possible string values of ImportFormat.
+func ImportFormatNames() []string {
+	tmp := make([]string, len(_ImportFormatNames))
+	copy(tmp, _ImportFormatNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ImportFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ImportFormat) IsValid() bool {
+	_, err := ParseImportFormat(string(x))
+	return err == nil
+}
+
+var _ImportFormatValue = map[string]ImportFormat{
+	"jsonresume": ImportFormatJsonresume,
+	"linkedin":   ImportFormatLinkedin,
+}
+
+// ParseImportFormat attempts to convert a string to a ImportFormat.
+func ParseImportFormat(name string) (ImportFormat, error) {
+	if x, ok := _ImportFormatValue[name]; ok {
+		return x, nil
+	}
+	return ImportFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidImportFormat)
+}
+
+const (
+	// PublicationSortNone is a PublicationSort of type none.
+	PublicationSortNone PublicationSort = "none"
+	// PublicationSortNewest is a PublicationSort of type newest.
+	PublicationSortNewest PublicationSort = "newest"
+	// PublicationSortOldest is a PublicationSort of type oldest.
+	PublicationSortOldest PublicationSort = "oldest"
+	// PublicationSortTitle is a PublicationSort of type title.
+	PublicationSortTitle PublicationSort = "title"
+)
+
+var ErrInvalidPublicationSort = fmt.Errorf("not a valid PublicationSort, try [%s]", strings.Join(_PublicationSortNames, ", "))
+
+var _PublicationSortNames = []string{
+	string(PublicationSortNone),
+	string(PublicationSortNewest),
+	string(PublicationSortOldest),
+	string(PublicationSortTitle),
+}
+
+// PublicationSortNames returns a list of possible string values of PublicationSort.
+func PublicationSortNames() []string {
+	tmp := make([]string, len(_PublicationSortNames))
+	copy(tmp, _PublicationSortNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x PublicationSort) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x PublicationSort) IsValid() bool {
+	_, err := ParsePublicationSort(string(x))
+	return err == nil
+}
+
+var _PublicationSortValue = map[string]PublicationSort{
+	"none":   PublicationSortNone,
+	"newest": PublicationSortNewest,
+	"oldest": PublicationSortOldest,
+	"title":  PublicationSortTitle,
+}
+
+// ParsePublicationSort attempts to convert a string to a PublicationSort.
+func ParsePublicationSort(name string) (PublicationSort, error) {
+	if x, ok := _PublicationSortValue[name]; ok {
+		return x, nil
+	}
+	return PublicationSort(""), fmt.Errorf("%s is %w", name, ErrInvalidPublicationSort)
+}
+
 const (
 	// SchemaTypeYaml is a SchemaType of type yaml.
 	SchemaTypeYaml SchemaType = "yaml"
 	// SchemaTypeYml is a SchemaType of type yml.
 	SchemaTypeYml SchemaType = "yml"
+	// SchemaTypeJson is a SchemaType of type json.
+	SchemaTypeJson SchemaType = "json"
+	// SchemaTypeToml is a SchemaType of type toml.
+	SchemaTypeToml SchemaType = "toml"
 )
 
 var ErrInvalidSchemaType = fmt.Errorf("not a valid SchemaType, try [%s]", strings.Join(_SchemaTypeNames, ", "))
@@ -23,6 +127,8 @@ var ErrInvalidSchemaType = fmt.Errorf("not a valid SchemaType, try [%s]", string
 var _SchemaTypeNames = []string{
 	string(SchemaTypeYaml),
 	string(SchemaTypeYml),
+	string(SchemaTypeJson),
+	string(SchemaTypeToml),
 }
 
 // SchemaTypeNames returns a list of possible string values of SchemaType.
@@ -47,6 +153,8 @@ func (x SchemaType) IsValid() bool {
 var _SchemaTypeValue = map[string]SchemaType{
 	"yaml": SchemaTypeYaml,
 	"yml":  SchemaTypeYml,
+	"json": SchemaTypeJson,
+	"toml": SchemaTypeToml,
 }
 
 // ParseSchemaType attempts to convert a string to a SchemaType.
@@ -189,6 +189,31 @@ projects: {entities: [{details: [""]}]}`,
 				"Schema.Projects.Entities[0].Details[0]": "min",
 			},
 		},
+		{
+			name: "invalid dates",
+			content: `template: {path: "path"}
+bio: {name: "ho", title: "title"}
+workExperiences: {entities: [{title: "t", company: "c", startDate: "2020-13", endDate: "soon"}]}
+educations: {entities: [{degree: "d", field: "f", university: "u", startDate: "2020-05", endDate: "2019"}]}
+certificates: {entities: [{title: "t", issueDate: "2021-03-01", expirationDate: "2021-02-28"}]}
+publications: {entities: [{title: "t", publisher: "p", publishDate: "last year", link: "https://x.com"}]}`,
+			failedValidationFields: map[string]string{
+				"Schema.WorkExperiences.Entities[0].StartDate":   "date",
+				"Schema.WorkExperiences.Entities[0].EndDate":     "date",
+				"Schema.Educations.Entities[0].EndDate":          "dateafter",
+				"Schema.Certificates.Entities[0].ExpirationDate": "dateafter",
+				"Schema.Publications.Entities[0].PublishDate":    "date",
+			},
+		},
+		{
+			name: "valid dates",
+			content: `template: {path: "path"}
+bio: {name: "ho", title: "title"}
+workExperiences: {entities: [{title: "t", company: "c", startDate: 2020-02-29, endDate: "Present"}]}
+educations: {entities: [{degree: "d", field: "f", university: "u", startDate: "2020-05", endDate: 2020}]}
+certificates: {entities: [{title: "t", issueDate: "Mar 2021", expirationDate: "2021-03-02"}]}
+publications: {entities: [{title: "t", publisher: "p", publishDate: "09/2016", link: "https://x.com"}]}`,
+		},
 		{
 			name: "invalid custom sections entity",
 			content: `template: {path: "path"}
@@ -254,6 +279,106 @@ customSections: [{details: ["a"], header: ""}]`,
 	}
 }
 
+func TestNewSchema_Formats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		content     string
+		contentType types.SchemaType
+		hasError    bool
+	}{
+		{
+			name: "yaml",
+			content: `template: {path: "path"}
+bio: {name: "John Doe", title: "Engineer"}
+workExperiences: {entities: [{title: "Engineer", company: "Acme", startDate: "2020"}]}
+skills: {entities: [{category: "Backend", items: [{name: "Go", level: 5}]}]}`,
+			contentType: types.SchemaTypeYaml,
+		},
+		{
+			name: "json",
+			content: `{
+  "template": {"path": "path"},
+  "bio": {"name": "John Doe", "title": "Engineer"},
+  "workExperiences": {"entities": [{"title": "Engineer", "company": "Acme", "startDate": "2020"}]},
+  "skills": {"entities": [{"category": "Backend", "items": [{"name": "Go", "level": 5}]}]}
+}`,
+			contentType: types.SchemaTypeJson,
+		},
+		{
+			name: "toml",
+			content: `[template]
+path = "path"
+
+[bio]
+name = "John Doe"
+title = "Engineer"
+
+[[workExperiences.entities]]
+title = "Engineer"
+company = "Acme"
+startDate = "2020"
+
+[[skills.entities]]
+category = "Backend"
+items = [{name = "Go", level = 5}]`,
+			contentType: types.SchemaTypeToml,
+		},
+		{
+			name:        "invalid json",
+			content:     `{"template": `,
+			contentType: types.SchemaTypeJson,
+			hasError:    true,
+		},
+		{
+			name:        "invalid toml",
+			content:     `template = `,
+			contentType: types.SchemaTypeToml,
+			hasError:    true,
+		},
+		{
+			name:        "unsupported type",
+			content:     `template: {path: "path"}`,
+			contentType: types.SchemaType("ini"),
+			hasError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				data, err := types.NewSchema([]byte(tc.content), tc.contentType)
+
+				if tc.hasError {
+					require.Error(t, err)
+					require.Nil(t, data)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.NoError(t, data.IsValid())
+
+				require.Equal(t, "path", data.Template.Path)
+				require.Equal(t, "John Doe", data.Bio.Name)
+				require.Equal(t, types.PageSizeA4, data.Page.Size)
+
+				require.NotNil(t, data.WorkExperiences)
+				require.Equal(t, "Work Experiences", data.WorkExperiences.Header)
+				require.Len(t, data.WorkExperiences.Entities, 1)
+				require.Equal(t, "Acme", data.WorkExperiences.Entities[0].Company)
+				require.Equal(t, types.DatePresent, data.WorkExperiences.Entities[0].EndDate)
+
+				require.NotNil(t, data.Skills)
+				require.Equal(t, uint8(5), data.Skills.Entities[0].Items[0].Level)
+			},
+		)
+	}
+}
+
 func TestExampleSchema(t *testing.T) {
 	t.Parallel()
 
@@ -383,3 +508,77 @@ func TestExampleSchema(t *testing.T) {
 		)
 	}
 }
+
+func TestMarshalDocument(t *testing.T) {
+	t.Parallel()
+
+	document, err := types.UnmarshalDocument(sampleSchema, types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+
+	for _, contentType := range []types.SchemaType{
+		types.SchemaTypeYaml, types.SchemaTypeJson, types.SchemaTypeToml,
+	} {
+		t.Run(
+			contentType.String(), func(t *testing.T) {
+				t.Parallel()
+
+				content, err := types.MarshalDocument(document, contentType)
+
+				require.NoError(t, err)
+
+				data, err := types.NewSchema(content, contentType)
+
+				require.NoError(t, err)
+				require.NoError(t, data.IsValid())
+				require.Equal(t, "Work Experiences2", data.WorkExperiences.Header)
+				require.Len(t, data.CustomSections, 2)
+			},
+		)
+	}
+
+	_, err = types.MarshalDocument(document, types.SchemaType("ini"))
+
+	require.ErrorIs(t, err, types.ErrInvalidSchemaType)
+}
+
+func TestUnmarshalDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		content     string
+		contentType types.SchemaType
+	}{
+		{
+			name:        "yaml",
+			content:     "date: 2020-01-02\nentities: [{date: 2020-01-02}]",
+			contentType: types.SchemaTypeYaml,
+		},
+		{
+			name:        "toml",
+			content:     "date = 2020-01-02\n[[entities]]\ndate = 2020-01-02",
+			contentType: types.SchemaTypeToml,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				document, err := types.UnmarshalDocument([]byte(tc.content), tc.contentType)
+
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"date":     "2020-01-02",
+						"entities": []any{map[string]any{"date": "2020-01-02"}},
+					},
+					document,
+				)
+			},
+		)
+	}
+}
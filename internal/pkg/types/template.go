@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"html/template"
 	"regexp"
 	"strings"
@@ -12,6 +13,11 @@ var reDomain = regexp.MustCompile(`^(?:http://|https://)?(?:www\.)?([\w-]+)\.\w+
 
 const TemplateRegistryPath = "https://raw.githubusercontent.com/seinshah/civic/refs/heads/main/templates"
 
+// TemplateVersion is the version of the registry templates rendered by the app. The released
+// apps keep loading the version they are built with from the registry, so a template relying
+// on the data that they lack (e.g. a new schema field or method) goes in a new version.
+const TemplateVersion = 1
+
 // SocialMediaPlatform is the data representing the name of a social media.
 // ENUM(facebook, github, gitlab, linkedin, mastodon, reddit, stackoverflow, x-twitter, youtube, other).
 type SocialMediaPlatform string
@@ -78,8 +84,10 @@ func (sbc *SchemaBioContact) ParsedSocials() []SocialMediaLink {
 	return mediaLinks
 }
 
-func UnescapeHTML(s string) template.HTML {
-	return template.HTML(s) //nolint:gosec
+// UnescapeHTML marks the provided value as safe HTML. Any value that is not a string
+// (e.g. a detail line) is printed in its default format.
+func UnescapeHTML(value any) template.HTML {
+	return template.HTML(fmt.Sprint(value)) //nolint:gosec
 }
 
 func socialDomainUsernameRegexPatter(domain SocialMediaPlatform) string {
@@ -0,0 +1,129 @@
+// Package watcher polls local files for changes. Polling is used over the file system
+// notifications, as editors often replace the files on save instead of writing them,
+// which breaks the notifications bound to the replaced files.
+package watcher
+
+import (
+	"context"
+	"maps"
+	"os"
+	"time"
+)
+
+const (
+	defaultInterval = 300 * time.Millisecond
+	defaultDebounce = 500 * time.Millisecond
+)
+
+type Watcher struct {
+	interval time.Duration
+	debounce time.Duration
+}
+
+type Option func(*Watcher)
+
+// WithInterval sets how often the files are checked for changes.
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithDebounce sets how long the files need to stay unchanged before the changes are reported,
+// so a burst of changes (e.g. saving several files at once) is reported once.
+func WithDebounce(debounce time.Duration) Option {
+	return func(w *Watcher) {
+		w.debounce = debounce
+	}
+}
+
+func New(opts ...Option) *Watcher {
+	watcher := &Watcher{
+		interval: defaultInterval,
+		debounce: defaultDebounce,
+	}
+
+	for _, opt := range opts {
+		opt(watcher)
+	}
+
+	return watcher
+}
+
+// fileState is the state of a file used to detect the changes. A missing file has a zero state.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Watch calls onChange whenever any of the files returned by paths changes, is created or
+// is removed. The paths are read again after each onChange call, so the watched files can
+// change between the calls. It blocks until the context is done.
+func (w *Watcher) Watch(ctx context.Context, paths func() []string, onChange func(ctx context.Context)) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var (
+		watched    = paths()
+		states     = snapshot(watched)
+		lastChange time.Time
+		pending    bool
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case now := <-ticker.C:
+			if current := snapshot(watched); !maps.Equal(states, current) {
+				states = current
+				lastChange = now
+				pending = true
+
+				continue
+			}
+
+			if !pending || now.Sub(lastChange) < w.debounce {
+				continue
+			}
+
+			pending = false
+
+			onChange(ctx)
+
+			// The files watched before are compared with their states before onChange, so the
+			// changes made while it runs are reported too. Only the new files are snapshotted now.
+			watched = paths()
+			states = extendSnapshot(states, watched)
+		}
+	}
+}
+
+func snapshot(paths []string) map[string]fileState {
+	return extendSnapshot(nil, paths)
+}
+
+// extendSnapshot returns the states of the paths, keeping the previous states of the paths
+// that have one.
+func extendSnapshot(previous map[string]fileState, paths []string) map[string]fileState {
+	states := make(map[string]fileState, len(paths))
+
+	for _, path := range paths {
+		if state, ok := previous[path]; ok {
+			states[path] = state
+
+			continue
+		}
+
+		var state fileState
+
+		if info, err := os.Stat(path); err == nil {
+			state = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+
+		states[path] = state
+	}
+
+	return states
+}
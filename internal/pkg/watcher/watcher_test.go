@@ -0,0 +1,141 @@
+package watcher_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seinshah/civic/internal/pkg/watcher"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_Watch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		change  func(t *testing.T, path string)
+		changes int32
+	}{
+		{
+			name: "no change",
+			change: func(t *testing.T, _ string) {
+				t.Helper()
+			},
+		},
+		{
+			name: "modified file",
+			change: func(t *testing.T, path string) {
+				t.Helper()
+
+				require.NoError(t, os.WriteFile(path, []byte("changed content"), 0o600))
+			},
+			changes: 1,
+		},
+		{
+			name: "burst of changes",
+			change: func(t *testing.T, path string) {
+				t.Helper()
+
+				for i := range 3 {
+					require.NoError(t, os.WriteFile(path, []byte("changed"+string(rune('a'+i))+"!"), 0o600))
+					time.Sleep(20 * time.Millisecond)
+				}
+			},
+			changes: 1,
+		},
+		{
+			name: "removed file",
+			change: func(t *testing.T, path string) {
+				t.Helper()
+
+				require.NoError(t, os.Remove(path))
+			},
+			changes: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				path := filepath.Join(t.TempDir(), "cv.yaml")
+				require.NoError(t, os.WriteFile(path, []byte("content"), 0o600))
+
+				ctx, cancel := context.WithCancel(t.Context())
+
+				var (
+					changes atomic.Int32
+					done    = make(chan error, 1)
+				)
+
+				go func() {
+					done <- watcher.New(
+						watcher.WithInterval(10*time.Millisecond),
+						watcher.WithDebounce(100*time.Millisecond),
+					).Watch(
+						ctx,
+						func() []string { return []string{path} },
+						func(context.Context) { changes.Add(1) },
+					)
+				}()
+
+				// Let the watcher take the initial snapshot.
+				time.Sleep(50 * time.Millisecond)
+
+				tc.change(t, path)
+
+				time.Sleep(500 * time.Millisecond)
+				cancel()
+
+				require.NoError(t, <-done)
+				require.Equal(t, tc.changes, changes.Load())
+			},
+		)
+	}
+}
+
+func TestWatcher_WatchChangeDuringOnChange(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cv.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o600))
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	var (
+		changes atomic.Int32
+		done    = make(chan error, 1)
+	)
+
+	go func() {
+		done <- watcher.New(
+			watcher.WithInterval(10*time.Millisecond),
+			watcher.WithDebounce(50*time.Millisecond),
+		).Watch(
+			ctx,
+			func() []string { return []string{path} },
+			func(context.Context) {
+				// The file is saved again while the first change is being handled.
+				if changes.Add(1) == 1 {
+					require.NoError(t, os.WriteFile(path, []byte("changed during onChange"), 0o600))
+				}
+			},
+		)
+	}()
+
+	// Let the watcher take the initial snapshot.
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, os.WriteFile(path, []byte("changed"), 0o600))
+
+	require.Eventually(t, func() bool { return changes.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,267 @@
+// Package preview serves the CV over HTTP, rendering the schema on every request,
+// so the changes of the schema file and the template are visible on refresh.
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/seinshah/civic/internal/pkg/watcher"
+)
+
+const (
+	eventsPath        = "/events"
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// reloadHook reloads the page whenever the server pushes a reload event.
+const reloadHook = `<script data-source="` + types.DefaultAppName + `_preview">` +
+	`new EventSource("` + eventsPath + `").addEventListener("reload", () => location.reload());` +
+	`</script>`
+
+// Renderer renders the CV in memory.
+type Renderer interface {
+	Render(ctx context.Context, outputType types.OutputType) ([]byte, error)
+
+	// LoadedFiles returns the local files used to render the CV, which are watched in the
+	// development mode.
+	LoadedFiles() []string
+}
+
+type options struct {
+	devMode        bool
+	watcherOptions []watcher.Option
+}
+
+type Option func(*options)
+
+// WithDevMode injects a reload hook in the HTML pages, and reloads them whenever
+// the files used to render the CV change.
+func WithDevMode() Option {
+	return func(o *options) {
+		o.devMode = true
+	}
+}
+
+// WithWatcherOptions configures how the files are watched in the development mode.
+func WithWatcherOptions(opts ...watcher.Option) Option {
+	return func(o *options) {
+		o.watcherOptions = append(o.watcherOptions, opts...)
+	}
+}
+
+type Server struct {
+	renderer Renderer
+	config   options
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewServer(renderer Renderer, opts ...Option) *Server {
+	var instanceOpts options
+
+	for _, opt := range opts {
+		opt(&instanceOpts)
+	}
+
+	return &Server{
+		renderer:    renderer,
+		config:      instanceOpts,
+		subscribers: make(map[chan struct{}]struct{}),
+	}
+}
+
+// Handler returns the HTTP handler serving the HTML page on "/", the PDF on "/cv.pdf",
+// and the reload events on "/events" in the development mode.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /{$}", s.handleHTML)
+	mux.HandleFunc("GET /cv.pdf", s.handlePDF)
+
+	if s.config.devMode {
+		mux.HandleFunc("GET "+eventsPath, s.handleEvents)
+	}
+
+	return mux
+}
+
+// Serve serves the CV on the listener until the context is done. In the development mode,
+// it also watches the files used to render the CV.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+		// The reload events are streamed until the context is done.
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+
+	if s.config.devMode {
+		// The first render discovers the files to watch.
+		if _, err := s.renderer.Render(ctx, types.OutputTypeHtml); err != nil {
+			slog.Error("Failed to render the CV", "error", err)
+		}
+
+		go func() {
+			if err := watcher.New(s.config.watcherOptions...).Watch(ctx, s.renderer.LoadedFiles, s.reload); err != nil {
+				slog.Error("Failed to watch the files", "error", err)
+			}
+		}()
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Failed to shut down the server", "error", err)
+		}
+	}()
+
+	slog.Info("Serving the CV on http://" + listener.Addr().String())
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// ListenAndServe listens on the address and serves the CV until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context, address string) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+func (s *Server) handleHTML(w http.ResponseWriter, r *http.Request) {
+	content, err := s.renderer.Render(r.Context(), types.OutputTypeHtml)
+	if err != nil {
+		slog.Error("Failed to render the CV", "error", err)
+
+		content = []byte("<!DOCTYPE html><html><body><h1>Failed to render the CV</h1><pre>" +
+			html.EscapeString(err.Error()) + "</pre></body></html>")
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	if s.config.devMode {
+		content = injectReloadHook(content)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+
+	_, _ = w.Write(content)
+}
+
+func (s *Server) handlePDF(w http.ResponseWriter, r *http.Request) {
+	content, err := s.renderer.Render(r.Context(), types.OutputTypePdf)
+	if err != nil {
+		slog.Error("Failed to render the PDF", "error", err)
+		http.Error(w, "failed to render the PDF: "+err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Cache-Control", "no-store")
+
+	_, _ = w.Write(content)
+}
+
+// handleEvents streams the reload events to the page using the Server-Sent Events.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+
+		return
+	}
+
+	events := s.subscribe()
+	defer s.unsubscribe(events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Connection", "keep-alive")
+
+	_, _ = fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-events:
+			_, _ = fmt.Fprint(w, "event: reload\ndata: reload\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// reload renders the CV to discover the files to watch next, then reloads the pages.
+func (s *Server) reload(ctx context.Context) {
+	slog.Info("Detected changes, reloading the pages")
+
+	if _, err := s.renderer.Render(ctx, types.OutputTypeHtml); err != nil {
+		slog.Error("Failed to render the CV", "error", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for subscriber := range s.subscribers {
+		// A pending reload is enough for a page that has not consumed the previous one.
+		select {
+		case subscriber <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *Server) subscribe() chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscriber := make(chan struct{}, 1)
+	s.subscribers[subscriber] = struct{}{}
+
+	return subscriber
+}
+
+func (s *Server) unsubscribe(subscriber chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subscribers, subscriber)
+}
+
+// injectReloadHook adds the reload hook at the end of the body, or at the end of the page
+// if there is no body closing tag.
+func injectReloadHook(content []byte) []byte {
+	page := string(content)
+
+	if index := strings.LastIndex(strings.ToLower(page), "</body>"); index >= 0 {
+		return []byte(page[:index] + reloadHook + page[index:])
+	}
+
+	return []byte(page + reloadHook)
+}
@@ -0,0 +1,192 @@
+package preview_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seinshah/civic/internal/pkg/types"
+	"github.com/seinshah/civic/internal/pkg/watcher"
+	"github.com/seinshah/civic/internal/preview"
+	"github.com/stretchr/testify/require"
+)
+
+var errRender = errors.New("render <failed>")
+
+type fakeRenderer struct {
+	files []string
+	err   error
+}
+
+func (f fakeRenderer) Render(_ context.Context, outputType types.OutputType) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if outputType == types.OutputTypePdf {
+		return []byte("%PDF-1.4"), nil
+	}
+
+	return []byte("<html><body><h1>John Doe</h1></body></html>"), nil
+}
+
+func (f fakeRenderer) LoadedFiles() []string {
+	return f.files
+}
+
+func TestServer_Handler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		renderer    fakeRenderer
+		options     []preview.Option
+		path        string
+		status      int
+		contentType string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:        "html",
+			path:        "/",
+			status:      http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+			contains:    []string{"<h1>John Doe</h1></body>"},
+			notContains: []string{"EventSource"},
+		},
+		{
+			name:        "html in dev mode",
+			options:     []preview.Option{preview.WithDevMode()},
+			path:        "/",
+			status:      http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+			contains:    []string{`<h1>John Doe</h1><script data-source="civic_preview">new EventSource("/events")`},
+		},
+		{
+			name:        "failed html",
+			renderer:    fakeRenderer{err: errRender},
+			options:     []preview.Option{preview.WithDevMode()},
+			path:        "/",
+			status:      http.StatusInternalServerError,
+			contentType: "text/html; charset=utf-8",
+			contains:    []string{"render &lt;failed&gt;", "EventSource"},
+		},
+		{
+			name:        "pdf",
+			path:        "/cv.pdf",
+			status:      http.StatusOK,
+			contentType: "application/pdf",
+			contains:    []string{"%PDF-1.4"},
+		},
+		{
+			name:     "failed pdf",
+			renderer: fakeRenderer{err: errRender},
+			path:     "/cv.pdf",
+			status:   http.StatusInternalServerError,
+		},
+		{
+			name:   "events without dev mode",
+			path:   "/events",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "unknown path",
+			path:   "/unknown",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				recorder := httptest.NewRecorder()
+				request := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tc.path, nil)
+
+				preview.NewServer(tc.renderer, tc.options...).Handler().ServeHTTP(recorder, request)
+
+				require.Equal(t, tc.status, recorder.Code)
+
+				if tc.contentType != "" {
+					require.Equal(t, tc.contentType, recorder.Header().Get("Content-Type"))
+				}
+
+				for _, expected := range tc.contains {
+					require.Contains(t, recorder.Body.String(), expected)
+				}
+
+				for _, unexpected := range tc.notContains {
+					require.NotContains(t, recorder.Body.String(), unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestServer_Serve(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cv.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("bio: {}"), 0o600))
+
+	listener, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+
+	server := preview.NewServer(
+		fakeRenderer{files: []string{path}},
+		preview.WithDevMode(),
+		preview.WithWatcherOptions(watcher.WithInterval(10*time.Millisecond), watcher.WithDebounce(50*time.Millisecond)),
+	)
+
+	go func() {
+		done <- server.Serve(ctx, listener)
+	}()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+listener.Addr().String()+"/events", nil)
+	require.NoError(t, err)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+
+	defer response.Body.Close()
+
+	require.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(response.Body)
+
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, ": connected\n", line)
+
+	require.NoError(t, os.WriteFile(path, []byte("bio: {name: John Doe}"), 0o600))
+
+	var event strings.Builder
+
+	for !strings.Contains(event.String(), "event: reload\n") {
+		line, err = reader.ReadString('\n')
+		require.NoError(t, err)
+
+		event.WriteString(line)
+	}
+
+	cancel()
+
+	require.NoError(t, <-done)
+
+	// The stream is closed along with the server, so reading it does not block.
+	_, _ = io.ReadAll(response.Body)
+}
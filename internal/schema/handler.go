@@ -3,17 +3,25 @@ package schema
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 
 	"github.com/invopop/jsonschema"
+	"github.com/seinshah/civic/internal/pkg/jsonresume"
+	"github.com/seinshah/civic/internal/pkg/linkedin"
 	"github.com/seinshah/civic/internal/pkg/loader"
 	"github.com/seinshah/civic/internal/pkg/types"
 )
 
 const sampleConfigPath = "https://raw.githubusercontent.com/seinshah/civic/main/examples/example.schema.yaml"
 
+var (
+	ErrEmptyInputPath        = errors.New("input path is empty")
+	ErrInvalidImportedSchema = errors.New("imported resume does not result in a valid schema")
+)
+
 type Handler struct{}
 
 func NewHandler() *Handler {
@@ -47,6 +55,14 @@ func (h *Handler) Init(
 
 	slog.Debug("loaded sample schema file", "writingTo", outputPath)
 
+	// The sample file is maintained in YAML, so other formats need a conversion.
+	// YAML is written as is to preserve the comments of the sample file.
+	if x != types.SchemaTypeYaml && x != types.SchemaTypeYml {
+		if conf, err = convertSchema(conf, types.SchemaTypeYaml, x); err != nil {
+			return fmt.Errorf("failed to convert sample schema file to %s: %w", x, err)
+		}
+	}
+
 	if err = os.WriteFile(outputPath, conf, types.DefaultFilePermission); err != nil {
 		return fmt.Errorf("failed to write the schema file: %w", err)
 	}
@@ -56,6 +72,83 @@ func (h *Handler) Init(
 	return nil
 }
 
+// Import converts the resume in the provided format to a schema file.
+// The fields of the resume that could not be mapped onto the schema are reported.
+func (h *Handler) Import(
+	ctx context.Context,
+	inputPath string,
+	format types.ImportFormat,
+	outputPath string,
+) error {
+	if inputPath == "" {
+		return ErrEmptyInputPath
+	}
+
+	if outputPath == "" {
+		return types.ErrEmptyOutputPath
+	}
+
+	outputType := types.DetectFileType[types.SchemaType](outputPath)
+	if !outputType.IsValid() {
+		return fmt.Errorf(
+			"%w: couldn't detect the file type from %s. (valid types: %v)",
+			types.ErrInvalidSchemaType, outputPath, types.SchemaTypeNames(),
+		)
+	}
+
+	inputLoader, err := loader.NewGeneralLoader(inputPath)
+	if err != nil {
+		return fmt.Errorf("failed to load the input file (%s): %w", inputPath, err)
+	}
+
+	content, err := inputLoader.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load the input file (%s): %w", inputPath, err)
+	}
+
+	var (
+		imported *types.Schema
+		unmapped []string
+	)
+
+	switch format {
+	case types.ImportFormatJsonresume:
+		imported, unmapped, err = jsonresume.Convert(content)
+	case types.ImportFormatLinkedin:
+		imported, unmapped, err = linkedin.Convert(content)
+	default:
+		return fmt.Errorf("%w: %s", types.ErrInvalidImportFormat, format)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, field := range unmapped {
+		slog.Warn("Field could not be mapped to the schema and is not imported", "field", field)
+	}
+
+	if err = imported.IsValid(); err != nil {
+		return errors.Join(ErrInvalidImportedSchema, err)
+	}
+
+	encoded, err := types.EncodeSchema(imported, outputType)
+	if err != nil {
+		return fmt.Errorf("failed to encode the schema file: %w", err)
+	}
+
+	if err = os.WriteFile(outputPath, encoded, types.DefaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write the schema file: %w", err)
+	}
+
+	slog.Info(
+		"Resume imported successfully",
+		"path", outputPath, "unmappedFields", len(unmapped),
+	)
+
+	return nil
+}
+
 func (h *Handler) JSON(_ context.Context) error {
 	outputPath := types.CurrentWDPath(types.DefaultSchemaJSONFileName)
 
@@ -80,3 +173,12 @@ func (h *Handler) JSON(_ context.Context) error {
 
 	return nil
 }
+
+func convertSchema(content []byte, from types.SchemaType, to types.SchemaType) ([]byte, error) {
+	document, err := types.UnmarshalDocument(content, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.MarshalDocument(document, to)
+}
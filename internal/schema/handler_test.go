@@ -1,6 +1,8 @@
 package schema_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
@@ -29,6 +31,14 @@ func TestHandler_Init(t *testing.T) {
 			name:       "success",
 			outputPath: os.TempDir() + "/test.yaml",
 		},
+		{
+			name:       "success json",
+			outputPath: os.TempDir() + "/test.json",
+		},
+		{
+			name:       "success toml",
+			outputPath: os.TempDir() + "/test.toml",
+		},
 		{
 			name:     "empty_path",
 			hasError: true,
@@ -36,7 +46,7 @@ func TestHandler_Init(t *testing.T) {
 		},
 		{
 			name:       "invalid type",
-			outputPath: os.TempDir() + "/test.json",
+			outputPath: os.TempDir() + "/test.ini",
 			hasError:   true,
 			err:        types.ErrInvalidSchemaType,
 		},
@@ -105,7 +115,174 @@ func TestHandler_Init(t *testing.T) {
 				require.NoError(t, err)
 				require.NotEmpty(t, content)
 				require.Greater(t, len(content), 1)
+
+				data, err := types.NewSchema(content, types.DetectFileType[types.SchemaType](expectedPath))
+
+				require.NoError(t, err)
+				require.NoError(t, data.IsValid())
+			},
+		)
+	}
+}
+
+func TestHandler_Import(t *testing.T) {
+	t.Parallel()
+
+	h := schema.NewHandler()
+
+	validResume := `{
+  "basics": {"name": "John Doe", "label": "Programmer", "email": "john@gmail.com"},
+  "work": [{"name": "Company", "position": "President", "startDate": "2013-01-01", "url": "https://company.com"}],
+  "skills": [{"name": "Web Development", "keywords": ["HTML", "CSS"]}]
+}`
+
+	testCases := []struct {
+		name       string
+		resume     string
+		inputPath  string
+		format     types.ImportFormat
+		outputFile string
+		err        error
+	}{
+		{
+			name:       "success yaml",
+			resume:     validResume,
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.yaml",
+		},
+		{
+			name:       "success json",
+			resume:     validResume,
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.json",
+		},
+		{
+			name:       "success toml",
+			resume:     validResume,
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.toml",
+		},
+		{
+			name:       "invalid output type",
+			resume:     validResume,
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.ini",
+			err:        types.ErrInvalidSchemaType,
+		},
+		{
+			name:       "invalid format",
+			resume:     validResume,
+			format:     types.ImportFormat("europass"),
+			outputFile: "cv.yaml",
+			err:        types.ErrInvalidImportFormat,
+		},
+		{
+			name:       "empty input path",
+			inputPath:  "-",
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.yaml",
+			err:        schema.ErrEmptyInputPath,
+		},
+		{
+			name:       "invalid resume",
+			resume:     `{"basics": `,
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.yaml",
+		},
+		{
+			name:       "invalid imported schema",
+			resume:     `{"basics": {"name": "John Doe"}}`,
+			format:     types.ImportFormatJsonresume,
+			outputFile: "cv.yaml",
+			err:        schema.ErrInvalidImportedSchema,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				dir := t.TempDir()
+				inputPath := filepath.Join(dir, "resume.json")
+				outputPath := filepath.Join(dir, tc.outputFile)
+
+				require.NoError(t, os.WriteFile(inputPath, []byte(tc.resume), 0o600))
+
+				if tc.inputPath == "-" {
+					inputPath = ""
+				}
+
+				err := h.Import(t.Context(), inputPath, tc.format, outputPath)
+
+				if tc.err != nil || tc.resume != validResume {
+					require.Error(t, err)
+
+					if tc.err != nil {
+						require.ErrorIs(t, err, tc.err)
+					}
+
+					require.NoFileExists(t, outputPath)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				content, err := os.ReadFile(filepath.Clean(outputPath))
+
+				require.NoError(t, err)
+
+				data, err := types.NewSchema(content, types.DetectFileType[types.SchemaType](outputPath))
+
+				require.NoError(t, err)
+				require.NoError(t, data.IsValid())
+				require.Equal(t, "genesis", data.Template.Name)
+				require.Equal(t, "Company", data.WorkExperiences.Entities[0].Company)
+				require.Equal(t, types.DatePresent, data.WorkExperiences.Entities[0].EndDate)
+				require.Equal(t, "Skills", data.Skills.Header)
 			},
 		)
 	}
 }
+
+func TestHandler_Import_LinkedIn(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "archive.zip")
+	outputPath := filepath.Join(dir, "cv.yaml")
+
+	var buf bytes.Buffer
+
+	writer := zip.NewWriter(&buf)
+
+	for name, content := range map[string]string{
+		"Profile.csv":         "First Name,Last Name,Headline\nJohn,Doe,Software Engineer\n",
+		"Email Addresses.csv": "Email Address,Confirmed,Primary,Updated On\njohn@example.com,Yes,Yes,2021-01-01\n",
+		"Positions.csv":       "Company Name,Title,Description,Location,Started On,Finished On\nAcme,Engineer,,,Jan 2020,\n",
+	} {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+	require.NoError(t, os.WriteFile(inputPath, buf.Bytes(), 0o600))
+
+	require.NoError(t, schema.NewHandler().Import(t.Context(), inputPath, types.ImportFormatLinkedin, outputPath))
+
+	content, err := os.ReadFile(filepath.Clean(outputPath))
+
+	require.NoError(t, err)
+
+	data, err := types.NewSchema(content, types.SchemaTypeYaml)
+
+	require.NoError(t, err)
+	require.NoError(t, data.IsValid())
+	require.Equal(t, "John Doe", data.Bio.Name)
+	require.Equal(t, "john@example.com", data.Bio.Contact.Email)
+	require.Equal(t, "Acme", data.WorkExperiences.Entities[0].Company)
+}